@@ -0,0 +1,90 @@
+package xmlstruct
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// asciiTransliterations are the transliterations of runes that are not
+// transliterated by stripping diacritics.
+var asciiTransliterations = map[rune]string{
+	'Ä': "Ae",
+	'Æ': "AE",
+	'Ð': "D",
+	'Đ': "D",
+	'Ł': "L",
+	'Œ': "OE",
+	'Ö': "Oe",
+	'Ø': "O",
+	'Þ': "Th",
+	'Ü': "Ue",
+	'ß': "ss",
+	'ä': "ae",
+	'æ': "ae",
+	'ð': "d",
+	'đ': "d",
+	'ı': "i",
+	'ł': "l",
+	'œ': "oe",
+	'ö': "oe",
+	'ø': "o",
+	'þ': "th",
+	'ü': "ue",
+	'ẞ': "SS",
+}
+
+// asciiExportNameFunc returns an ExportNameFunc that transliterates names to
+// ASCII before passing them to exportNameFunc.
+func asciiExportNameFunc(exportNameFunc ExportNameFunc) ExportNameFunc {
+	return func(name xml.Name) string {
+		return exportNameFunc(xml.Name{
+			Space: name.Space,
+			Local: transliterateASCII(name.Local),
+		})
+	}
+}
+
+// transliterateASCII returns s transliterated to ASCII. Letters with
+// diacritics have their diacritics stripped, except for German umlauts and ß
+// which use their conventional transliterations. Other non-ASCII runes are
+// escaped as _u followed by their hexadecimal code point, so that, for example,
+// 日 becomes _u65e5.
+func transliterateASCII(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r <= unicode.MaxASCII {
+			sb.WriteRune(r)
+			continue
+		}
+		if transliteration, ok := asciiTransliterations[r]; ok {
+			sb.WriteString(transliteration)
+			continue
+		}
+		if decomposition, ok := stripDiacritics(r); ok {
+			sb.WriteString(decomposition)
+			continue
+		}
+		fmt.Fprintf(&sb, "_u%04x", r)
+	}
+	return sb.String()
+}
+
+// stripDiacritics returns r with its diacritics stripped and true, or false if
+// r is not an ASCII rune with diacritics.
+func stripDiacritics(r rune) (string, bool) {
+	var sb strings.Builder
+	for _, decomposedRune := range norm.NFKD.String(string(r)) {
+		switch {
+		case unicode.Is(unicode.Mn, decomposedRune):
+		case decomposedRune <= unicode.MaxASCII:
+			sb.WriteRune(decomposedRune)
+		default:
+			return "", false
+		}
+	}
+	return sb.String(), sb.Len() > 0
+}
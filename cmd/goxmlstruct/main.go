@@ -13,29 +13,56 @@ import (
 )
 
 var (
+	asciiIdentifiers             = pflag.Bool("ascii-identifiers", xmlstruct.DefaultASCIIIdentifiers, "transliterate names to ASCII identifiers")
 	attrNameSuffix               = pflag.String("attr-name-suffix", xmlstruct.DefaultAttrNameSuffix, "attribute name suffix")
+	bigIntType                   = pflag.String("big-int-type", xmlstruct.DefaultBigIntType, "type for integers that do not fit in 64 bits, *big.Int, string, or a qualified type")
+	binaryMinLength              = pflag.Int("binary-min-length", xmlstruct.DefaultBinaryMinLength, "minimum length of hex or base64 binary values, zero to disable")
+	catchAll                     = pflag.Bool("catch-all", xmlstruct.DefaultCatchAll, "add fields for attributes and elements that were not observed")
 	charDataFieldName            = pflag.String("char-data-field-name", xmlstruct.DefaultCharDataFieldName, "char data field name")
 	compactTypes                 = pflag.Bool("compact-types", xmlstruct.DefaultCompactTypes, "create compact types")
+	decimalType                  = pflag.String("decimal-type", xmlstruct.DefaultDecimalType, "decimal type, empty for float64")
+	dtd                          = pflag.StringSlice("dtd", nil, "DTD files to observe")
 	elemNameSuffix               = pflag.String("elem-name-suffix", xmlstruct.DefaultElemNameSuffix, "element name suffix")
+	entityEnums                  = pflag.Bool("entity-enums", xmlstruct.DefaultEntityEnums, "create enumerated types for entity values")
+	exclude                      = pflag.StringArray("exclude", nil, "path of elements or attributes to exclude, may be repeated")
+	falseValues                  = pflag.StringSlice("false-values", nil, "additional spellings of false, paired with --true-values")
+	fieldComments                = pflag.Bool("field-comments", xmlstruct.DefaultFieldComments, "write comments with example values above fields")
 	formatSource                 = pflag.Bool("format-source", xmlstruct.DefaultFormatSource, "format source")
 	header                       = pflag.String("header", xmlstruct.DefaultHeader, "header")
+	htmlMode                     = pflag.Bool("html-mode", xmlstruct.DefaultHTMLMode, "observe HTML-like documents")
 	ignoreErrors                 = pflag.Bool("ignore-errors", false, "ignore errors")
 	ignoreNamespaces             = pflag.Bool("ignore-namespaces", true, "ignore namespaces")
 	imports                      = pflag.Bool("imports", xmlstruct.DefaultImports, "generate import statements")
 	intType                      = pflag.String("int-type", xmlstruct.DefaultIntType, "int type")
+	langMaps                     = pflag.Bool("lang-maps", xmlstruct.DefaultLangMaps, "create maps for elements repeated by xml:lang")
+	nameConflicts                = pflag.String("name-conflicts", string(xmlstruct.DefaultNameConflictStrategy), "how to resolve duplicate names, error, suffix, namespace, or number")
 	namedRoot                    = pflag.Bool("named-root", xmlstruct.DefaultNamedRoot, "create an XMLName field for the root element")
 	namedTypes                   = pflag.Bool("named-types", xmlstruct.DefaultNamedTypes, "create named types for all elements")
 	noEmptyElements              = pflag.Bool("no-empty-elements", !xmlstruct.DefaultEmptyElements, "use type string instead of struct{} for empty elements")
 	noExport                     = pflag.Bool("no-export", false, "create unexported types")
+	omitEmpty                    = pflag.Bool("omit-empty", xmlstruct.DefaultOmitEmpty, "add omitempty to optional attributes and elements")
 	output                       = pflag.String("output", "", "output filename")
 	packageName                  = pflag.String("package-name", "main", "package name")
 	pattern                      = pflag.String("pattern", "", "filename pattern to observe")
+	plural                       = pflag.StringToString("plural", nil, "plurals of field names, for example Wpt=Waypoints")
+	pluralizeRepeated            = pflag.Bool("pluralize-repeated", xmlstruct.DefaultPluralizeRepeated, "pluralize field names of repeated elements")
+	preserveLeadingZeros         = pflag.Bool("preserve-leading-zeros", xmlstruct.DefaultPreserveLeadingZeros, "use strings for integers with leading zeros")
 	preserveOrder                = pflag.Bool("preserve-order", xmlstruct.DefaultPreserveOrder, "preserve order of types and fields")
+	raw                          = pflag.StringSlice("raw", nil, "names or paths of elements to keep as raw inner XML")
+	relaxNG                      = pflag.StringSlice("relaxng", nil, "RELAX NG schema files to observe")
+	rename                       = pflag.StringToString("rename", nil, "rename fields and types by path, for example /rss/channel/item/title=Headline")
+	sizedIntTypes                = pflag.Bool("sized-int-types", xmlstruct.DefaultSizedIntTypes, "use the narrowest int type for the observed range")
+	stringSubtypes               = pflag.Bool("string-subtypes", xmlstruct.DefaultStringSubtypes, "create URL, UUID, and Email types")
+	tagNameCase                  = pflag.String("tag-name-case", string(xmlstruct.DefaultTagNameCase), "case of names in additional struct tags, camel, snake, or original")
+	tags                         = pflag.StringSlice("tags", nil, "additional struct tags, for example json,yaml")
 	timeLayout                   = pflag.String("time-layout", "2006-01-02T15:04:05Z", "time layout")
 	topLevelAttributes           = pflag.Bool("top-level-attributes", xmlstruct.DefaultTopLevelAttributes, "include top level attributes")
+	trueValues                   = pflag.StringSlice("true-values", nil, "additional spellings of true, paired with --false-values")
+	typeConfidence               = pflag.Float64("type-confidence", xmlstruct.DefaultTypeConfidence, "fraction of values that must agree on a type, with outliers reported")
 	typesOnly                    = pflag.Bool("types-only", false, "generate structs only, without header, package, or imports")
 	usePointersForOptionalFields = pflag.Bool("use-pointers-for-optional-fields", xmlstruct.DefaultUsePointersForOptionalFields, "use pointers for optional fields")
 	useRawToken                  = pflag.Bool("use-raw-token", xmlstruct.DefaultUseRawToken, "use encoding/xml.Decoder.RawToken")
+	validateMethods              = pflag.Bool("validate-methods", xmlstruct.DefaultValidateMethods, "generate Validate methods")
 )
 
 func run() error {
@@ -53,24 +80,48 @@ func run() error {
 	}
 
 	options := []xmlstruct.GeneratorOption{
+		xmlstruct.WithASCIIIdentifiers(*asciiIdentifiers),
 		xmlstruct.WithAttrNameSuffix(*attrNameSuffix),
+		xmlstruct.WithBigIntType(*bigIntType),
+		xmlstruct.WithBinaryMinLength(*binaryMinLength),
+		xmlstruct.WithBoolValues(*trueValues, *falseValues),
+		xmlstruct.WithCatchAll(*catchAll),
 		xmlstruct.WithCharDataFieldName(*charDataFieldName),
 		xmlstruct.WithCompactTypes(*compactTypes),
+		xmlstruct.WithDecimalType(*decimalType),
 		xmlstruct.WithElemNameSuffix(*elemNameSuffix),
 		xmlstruct.WithEmptyElements(!*noEmptyElements),
+		xmlstruct.WithEntityEnums(*entityEnums),
+		xmlstruct.WithExcludePaths(*exclude),
+		xmlstruct.WithFieldComments(*fieldComments),
 		xmlstruct.WithFormatSource(*formatSource),
 		xmlstruct.WithHeader(*header),
+		xmlstruct.WithHTMLMode(*htmlMode),
 		xmlstruct.WithImports(*imports),
 		xmlstruct.WithIntType(*intType),
+		xmlstruct.WithLangMaps(*langMaps),
+		xmlstruct.WithNameConflictStrategy(xmlstruct.NameConflictStrategy(*nameConflicts)),
 		xmlstruct.WithNamedRoot(*namedRoot),
 		xmlstruct.WithNamedTypes(*namedTypes),
 		xmlstruct.WithNameFunc(nameFunc),
+		xmlstruct.WithOmitEmpty(*omitEmpty),
 		xmlstruct.WithPackageName(*packageName),
+		xmlstruct.WithPathRenames(*rename),
+		xmlstruct.WithPluralOverrides(*plural),
+		xmlstruct.WithPluralizeRepeated(*pluralizeRepeated),
+		xmlstruct.WithPreserveLeadingZeros(*preserveLeadingZeros),
 		xmlstruct.WithPreserveOrder(*preserveOrder),
+		xmlstruct.WithRawElements(*raw),
+		xmlstruct.WithSizedIntTypes(*sizedIntTypes),
+		xmlstruct.WithStringSubtypes(*stringSubtypes),
+		xmlstruct.WithTagNameCase(xmlstruct.TagNameCase(*tagNameCase)),
+		xmlstruct.WithTags(*tags),
 		xmlstruct.WithTimeLayout(*timeLayout),
 		xmlstruct.WithTopLevelAttributes(*topLevelAttributes),
+		xmlstruct.WithTypeConfidence(*typeConfidence),
 		xmlstruct.WithUsePointersForOptionalFields(*usePointersForOptionalFields),
 		xmlstruct.WithUseRawToken(*useRawToken),
+		xmlstruct.WithValidateMethods(*validateMethods),
 	}
 	if *noExport {
 		options = append(options, xmlstruct.WithExportTypeNameFunc(xmlstruct.DefaultUnexportNameFunc))
@@ -86,7 +137,19 @@ func run() error {
 		filenames = append(filenames, matches...)
 	}
 
-	if len(filenames) == 0 {
+	for _, filename := range *dtd {
+		if err := observeDTDFile(generator, filename); err != nil {
+			return fmt.Errorf("%s: %w", filename, err)
+		}
+	}
+
+	for _, filename := range *relaxNG {
+		if err := observeRelaxNGFile(generator, filename); err != nil {
+			return fmt.Errorf("%s: %w", filename, err)
+		}
+	}
+
+	if len(filenames) == 0 && len(*dtd) == 0 && len(*relaxNG) == 0 {
 		if err := generator.ObserveReader(os.Stdin); err != nil {
 			return err
 		}
@@ -106,6 +169,35 @@ func run() error {
 	if err != nil {
 		return err
 	}
+	for _, outlier := range generator.Outliers() {
+		filename := outlier.Filename
+		if filename == "" {
+			filename = "<stdin>"
+		}
+		name := outlier.Name.Local
+		if outlier.Attr {
+			name = "@" + name
+		}
+		fmt.Fprintf(os.Stderr, "%s:%d:%d: %s: %q is not a %s\n", filename, outlier.Line, outlier.Column, name, outlier.Value, outlier.GoType)
+	}
+	for _, nameConflict := range generator.NameConflicts() {
+		kind := "field"
+		if nameConflict.Type {
+			kind = "type"
+		}
+		name := nameConflict.Element.Local
+		switch {
+		case nameConflict.Attr:
+			name += "/@" + nameConflict.Name.Local
+		case nameConflict.Name.Local != "":
+			name += "/" + nameConflict.Name.Local
+		}
+		if name == "" {
+			fmt.Fprintf(os.Stderr, "renamed duplicate %s %s to %s\n", kind, nameConflict.OldName, nameConflict.NewName)
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "%s: renamed duplicate %s %s to %s\n", name, kind, nameConflict.OldName, nameConflict.NewName)
+	}
 
 	if *output == "" {
 		_, err := os.Stdout.Write(source)
@@ -114,6 +206,26 @@ func run() error {
 	return os.WriteFile(*output, source, 0o666)
 }
 
+// observeDTDFile observes the DTD in filename with generator.
+func observeDTDFile(generator *xmlstruct.Generator, filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return generator.ObserveDTD(file)
+}
+
+// observeRelaxNGFile observes the RELAX NG schema in filename with generator.
+func observeRelaxNGFile(generator *xmlstruct.Generator, filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return generator.ObserveRelaxNG(file)
+}
+
 func main() {
 	if err := run(); err != nil {
 		fmt.Println(err)
@@ -0,0 +1,103 @@
+package xmlstruct
+
+import (
+	"cmp"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// A NameConflictStrategy determines how duplicate field and type names are
+// resolved.
+type NameConflictStrategy string
+
+// Name conflict strategies.
+const (
+	// NameConflictStrategyError returns an error.
+	NameConflictStrategyError NameConflictStrategy = "error"
+	// NameConflictStrategyNamespace prefixes the name with the last word of
+	// its namespace.
+	NameConflictStrategyNamespace NameConflictStrategy = "namespace"
+	// NameConflictStrategyNumber suffixes the name with a number.
+	NameConflictStrategyNumber NameConflictStrategy = "number"
+	// NameConflictStrategySuffix suffixes attribute field names with Attr
+	// and element field names with Elem.
+	NameConflictStrategySuffix NameConflictStrategy = "suffix"
+)
+
+// A NameConflict is a duplicate field or type name that was resolved by
+// renaming.
+type NameConflict struct {
+	Element xml.Name // The element whose type or field was renamed, if any.
+	Name    xml.Name // The attribute or child element of a renamed field.
+	Attr    bool     // Whether Name is an attribute.
+	Type    bool     // Whether Element's type, rather than a field, was renamed.
+	OldName string   // The duplicate name.
+	NewName string   // The new name.
+}
+
+// resolveName returns conflict.OldName if it is not already in names.
+// Otherwise, it returns a new name according to o.nameConflictStrategy and
+// records conflict. kind is the kind of name, either "field" or "type", space
+// is the namespace of the name, and suffix is the suffix added by
+// NameConflictStrategySuffix. The returned name is added to names.
+func (o *generateOptions) resolveName(names map[string]struct{}, kind string, conflict NameConflict, space, suffix string) (string, error) {
+	if _, ok := names[conflict.OldName]; !ok {
+		names[conflict.OldName] = struct{}{}
+		return conflict.OldName, nil
+	}
+	var newName string
+	switch o.nameConflictStrategy {
+	case NameConflictStrategyNamespace:
+		if namespaceName := namespaceName(space); namespaceName != "" {
+			newName = namespaceName + conflict.OldName
+		}
+	case NameConflictStrategyNumber:
+	case NameConflictStrategySuffix:
+		if suffix != "" {
+			newName = conflict.OldName + suffix
+		}
+	default:
+		return "", fmt.Errorf("%s: duplicate %s name", conflict.OldName, kind)
+	}
+	if _, ok := names[newName]; ok || newName == "" {
+		base := cmp.Or(newName, conflict.OldName)
+		for i := 2; ; i++ {
+			newName = base + strconv.Itoa(i)
+			if _, ok := names[newName]; !ok {
+				break
+			}
+		}
+	}
+	names[newName] = struct{}{}
+	conflict.NewName = newName
+	conflict.Type = kind == "type"
+	o.nameConflicts = append(o.nameConflicts, conflict)
+	return newName, nil
+}
+
+// namespaceName returns an exported name derived from the last word of space
+// that starts with a letter, so that, for example,
+// http://www.opengis.net/gml/3.2 becomes Gml.
+func namespaceName(space string) string {
+	words := strings.FieldsFunc(space, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i := len(words) - 1; i >= 0; i-- {
+		if firstRune := []rune(words[i])[0]; unicode.IsLetter(firstRune) {
+			return DefaultExportNameFunc(xml.Name{Local: words[i]})
+		}
+	}
+	return ""
+}
+
+// compareNames returns the order of a and b by namespace and then by local
+// name.
+func compareNames(a, b xml.Name) int {
+	return cmp.Or(
+		cmp.Compare(a.Space, b.Space),
+		cmp.Compare(a.Local, b.Local),
+	)
+}
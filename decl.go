@@ -0,0 +1,423 @@
+package xmlstruct
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// A decl is a Go declaration, such as a helper type and its methods, that is
+// included in the generated Go source only when it is used.
+type decl struct {
+	name    string
+	imports []string
+	source  string
+}
+
+// anyElementDecl declares a type for elements that were not observed, preserving
+// their attributes and inner XML.
+var anyElementDecl = &decl{
+	name:    "AnyElement",
+	imports: []string{"encoding/xml"},
+	source: `// An AnyElement is an element that was not observed when generating this
+// source, with its attributes and inner XML preserved.
+type AnyElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr ` + "`xml:\",any,attr\"`" + `
+	InnerXML string     ` + "`xml:\",innerxml\"`" + `
+}
+`,
+}
+
+// base64BinaryDecl declares a type for base64-encoded binary data.
+var base64BinaryDecl = &decl{
+	name:    "Base64Binary",
+	imports: []string{"bytes", "encoding/base64"},
+	source: `// A Base64Binary is binary data encoded as base64.
+type Base64Binary []byte
+
+// MarshalText implements encoding.TextMarshaler.
+func (b Base64Binary) MarshalText() ([]byte, error) {
+	text := make([]byte, base64.StdEncoding.EncodedLen(len(b)))
+	base64.StdEncoding.Encode(text, b)
+	return text, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *Base64Binary) UnmarshalText(text []byte) error {
+	text = bytes.Join(bytes.Fields(text), nil)
+	data := make([]byte, base64.StdEncoding.DecodedLen(len(text)))
+	n, err := base64.StdEncoding.Decode(data, text)
+	if err != nil {
+		return err
+	}
+	*b = data[:n]
+	return nil
+}
+`,
+}
+
+// boolValuesSource is the source of a bool type with custom spellings.
+const boolValuesSource = `// A %[1]s is a bool represented as %[2]q or %[3]q.
+type %[1]s bool
+
+// MarshalText implements encoding.TextMarshaler.
+func (b %[1]s) MarshalText() ([]byte, error) {
+	if b {
+		return []byte(%[2]q), nil
+	}
+	return []byte(%[3]q), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *%[1]s) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case %[2]q:
+		*b = true
+	case %[3]q:
+		*b = false
+	default:
+		return fmt.Errorf("%%q: invalid %[1]s", text)
+	}
+	return nil
+}
+`
+
+// decimalSource is the source of a string-backed decimal type.
+const decimalSource = `// A Decimal is a decimal number that preserves its exact textual
+// representation.
+type Decimal string
+
+// Float64 returns d as a float64.
+func (d Decimal) Float64() (float64, error) {
+	return strconv.ParseFloat(string(d), 64)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	digits, point := 0, false
+	for i, c := range text {
+		switch {
+		case '0' <= c && c <= '9':
+			digits++
+		case c == '.' && !point:
+			point = true
+		case (c == '+' || c == '-') && i == 0:
+		default:
+			return fmt.Errorf("%q: invalid Decimal", text)
+		}
+	}
+	if digits == 0 {
+		return fmt.Errorf("%q: invalid Decimal", text)
+	}
+	*d = Decimal(text)
+	return nil
+}
+`
+
+// emailDecl declares a string type for email addresses.
+var emailDecl = &decl{
+	name:    "Email",
+	imports: []string{"fmt", "net/mail"},
+	source: `// An Email is an email address.
+type Email string
+
+// Validate returns an error if e is not a valid email address.
+func (e Email) Validate() error {
+	address, err := mail.ParseAddress(string(e))
+	if err != nil {
+		return err
+	}
+	if address.Name != "" || address.Address != string(e) {
+		return fmt.Errorf("%q: invalid Email", string(e))
+	}
+	return nil
+}
+`,
+}
+
+// hexBinaryDecl declares a type for hex-encoded binary data.
+var hexBinaryDecl = &decl{
+	name:    "HexBinary",
+	imports: []string{"bytes", "encoding/hex"},
+	source: `// A HexBinary is binary data encoded as hexadecimal.
+type HexBinary []byte
+
+// MarshalText implements encoding.TextMarshaler.
+func (b HexBinary) MarshalText() ([]byte, error) {
+	text := make([]byte, hex.EncodedLen(len(b)))
+	hex.Encode(text, b)
+	return text, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (b *HexBinary) UnmarshalText(text []byte) error {
+	text = bytes.Join(bytes.Fields(text), nil)
+	data := make([]byte, hex.DecodedLen(len(text)))
+	if _, err := hex.Decode(data, text); err != nil {
+		return err
+	}
+	*b = data
+	return nil
+}
+`,
+}
+
+// langMapDecl declares a map from xml:lang values to chardata for repeated
+// elements that differ only by language.
+var langMapDecl = &decl{
+	name:    "LangMap",
+	imports: []string{"encoding/xml", "fmt", "maps", "slices"},
+	source: `// A LangMap maps xml:lang values to chardata.
+type LangMap map[string]string
+
+// MarshalXML implements encoding/xml.Marshaler.
+func (m LangMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	for _, lang := range slices.Sorted(maps.Keys(m)) {
+		langStart := start
+		if lang != "" {
+			langStart.Attr = append(slices.Clip(start.Attr), xml.Attr{
+				Name:  xml.Name{Space: "` + xmlNamespace + `", Local: "lang"},
+				Value: lang,
+			})
+		}
+		if err := e.EncodeElement(m[lang], langStart); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.
+func (m *LangMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var lang string
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "lang" && (attr.Name.Space == "` + xmlNamespace + `" || attr.Name.Space == "xml") {
+			lang = attr.Value
+		}
+	}
+	var charData string
+	if err := d.DecodeElement(&charData, &start); err != nil {
+		return err
+	}
+	if *m == nil {
+		*m = make(LangMap)
+	}
+	if _, ok := (*m)[lang]; ok {
+		return fmt.Errorf("%q: duplicate xml:lang", lang)
+	}
+	(*m)[lang] = charData
+	return nil
+}
+`,
+}
+
+// nillableDecl declares a generic wrapper for elements that may be marked as
+// nil with xsi:nil="true".
+var nillableDecl = &decl{
+	name:    "Nillable",
+	imports: []string{"encoding/xml"},
+	source: `// A Nillable is an element that may be nil, indicated by xsi:nil="true".
+type Nillable[T any] struct {
+	Value *T
+}
+
+// MarshalXML implements encoding/xml.Marshaler.
+func (n Nillable[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if n.Value == nil {
+		start.Attr = append(start.Attr, xml.Attr{
+			Name:  xml.Name{Space: "` + xsiNamespace + `", Local: "nil"},
+			Value: "true",
+		})
+		if err := e.EncodeToken(start); err != nil {
+			return err
+		}
+		return e.EncodeToken(start.End())
+	}
+	return e.EncodeElement(n.Value, start)
+}
+
+// UnmarshalXML implements encoding/xml.Unmarshaler.
+func (n *Nillable[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && (attr.Name.Space == "` + xsiNamespace + `" || attr.Name.Space == "xsi") && (attr.Value == "true" || attr.Value == "1") {
+			n.Value = nil
+			return d.Skip()
+		}
+	}
+	n.Value = new(T)
+	return d.DecodeElement(n.Value, &start)
+}
+`,
+}
+
+// newDecimalDecl returns a declaration of a string-backed decimal type called
+// name.
+func newDecimalDecl(name string) *decl {
+	return &decl{
+		name:    name,
+		imports: []string{"fmt", "strconv"},
+		source:  strings.ReplaceAll(decimalSource, "Decimal", name),
+	}
+}
+
+// urlDecl declares a wrapper for absolute URLs.
+var urlDecl = &decl{
+	name:    "URL",
+	imports: []string{"net/url"},
+	source: `// A URL wraps an absolute *url.URL.
+type URL struct {
+	*url.URL
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (u URL) MarshalText() ([]byte, error) {
+	if u.URL == nil {
+		return nil, nil
+	}
+	return []byte(u.URL.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (u *URL) UnmarshalText(text []byte) error {
+	parsedURL, err := url.Parse(string(text))
+	if err != nil {
+		return err
+	}
+	u.URL = parsedURL
+	return nil
+}
+`,
+}
+
+// uuidDecl declares a string type for UUIDs.
+var uuidDecl = &decl{
+	name:    "UUID",
+	imports: []string{"fmt"},
+	source: `// A UUID is a UUID in its canonical textual representation.
+type UUID string
+
+// Validate returns an error if u is not a valid UUID.
+func (u UUID) Validate() error {
+	if len(u) != 36 {
+		return fmt.Errorf("%q: invalid UUID", string(u))
+	}
+	for i, c := range []byte(u) {
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return fmt.Errorf("%q: invalid UUID", string(u))
+			}
+		default:
+			if !('0' <= c && c <= '9' || 'A' <= c && c <= 'F' || 'a' <= c && c <= 'f') {
+				return fmt.Errorf("%q: invalid UUID", string(u))
+			}
+		}
+	}
+	return nil
+}
+`,
+}
+
+// addBoolValuesDecl records that the generated Go source uses the bool type
+// for the given pair of true and false values, and returns its name.
+func (o *generateOptions) addBoolValuesDecl(pair int) string {
+	trueValue, falseValue := o.trueValues[pair], o.falseValues[pair]
+	name := o.boolValuesTypeNames[pair]
+	return o.addDecl(&decl{
+		name:    name,
+		imports: []string{"fmt"},
+		source:  fmt.Sprintf(boolValuesSource, name, trueValue, falseValue),
+	})
+}
+
+// boolValuesTypeNames returns the names of the bool types for each pair of
+// true and false values. Pairs whose names differ only in case, like "Y" and
+// "N" and "y" and "n", are given distinct names by numbering all but the
+// first.
+func boolValuesTypeNames(trueValues, falseValues []string) []string {
+	typeNames := make([]string, 0, len(trueValues))
+	for pair, trueValue := range trueValues {
+		typeName := "Bool" + boolValueName(trueValue) + boolValueName(falseValues[pair])
+		if slices.Contains(typeNames, typeName) {
+			for i := 2; ; i++ {
+				if numberedTypeName := typeName + strconv.Itoa(i); !slices.Contains(typeNames, numberedTypeName) {
+					typeName = numberedTypeName
+					break
+				}
+			}
+		}
+		typeNames = append(typeNames, typeName)
+	}
+	return typeNames
+}
+
+// boolValueName returns the part of a bool type's name derived from value.
+func boolValueName(value string) string {
+	if value == "" {
+		return "Empty"
+	}
+	return DefaultExportNameFunc(xml.Name{Local: value})
+}
+
+// addDecl records that the generated Go source uses d and returns the name of
+// its type, which is d's name unless it is already the name of another type.
+func (o *generateOptions) addDecl(d *decl) string {
+	name := o.declName(d.name)
+	if name != d.name {
+		d = d.renamed(name)
+	}
+	o.decls[name] = d
+	for _, importPackageName := range d.imports {
+		o.importPackageNames[importPackageName] = struct{}{}
+	}
+	return name
+}
+
+// declName returns the name of the helper type called name. Helper types are
+// declared after the element types have been named, so if name is already
+// the name of another type then the helper type is renamed with a number,
+// whatever the name conflict strategy, and the conflict is recorded.
+func (o *generateOptions) declName(name string) string {
+	if declName, ok := o.declNames[name]; ok {
+		return declName
+	}
+	declName := name
+	if _, ok := o.typeNames[name]; ok {
+		for i := 2; ; i++ {
+			declName = name + strconv.Itoa(i)
+			if _, ok := o.typeNames[declName]; !ok {
+				break
+			}
+		}
+		o.nameConflicts = append(o.nameConflicts, NameConflict{
+			Type:    true,
+			OldName: name,
+			NewName: declName,
+		})
+	}
+	o.typeNames[declName] = struct{}{}
+	o.declNames[name] = declName
+	o.declNames[declName] = declName
+	return declName
+}
+
+// renamed returns a copy of d with its type renamed to name. Only whole
+// identifiers that are not selected from a package or value are renamed, so,
+// for example, url.URL is left unchanged.
+func (d *decl) renamed(name string) *decl {
+	nameRx := regexp.MustCompile(`(^|[^.\w])` + regexp.QuoteMeta(d.name) + `\b`)
+	return &decl{
+		name:    name,
+		imports: d.imports,
+		source:  nameRx.ReplaceAllString(d.source, "${1}"+name),
+	}
+}
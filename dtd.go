@@ -0,0 +1,424 @@
+package xmlstruct
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// maxParameterEntityDepth is the maximum depth of nested parameter entity
+// references in a DTD.
+const maxParameterEntityDepth = 16
+
+var errUnexpectedEndOfDTD = errors.New("unexpected end of DTD")
+
+// A dtdParser parses the declarations in a DTD.
+type dtdParser struct {
+	builder           *schemaBuilder
+	parameterEntities map[string]string
+}
+
+// A contentModel is a parsed content model of an element declaration.
+type contentModel struct {
+	charData    bool
+	childNames  []xml.Name
+	childOccurs map[xml.Name]occurs
+}
+
+// ObserveDTD observes the element and attribute list declarations in the DTD
+// read from r. Element declarations determine the child elements and chardata
+// of each element and attribute list declarations determine their attributes.
+// Internal parameter entities are expanded, but external entities and
+// conditional sections are not supported.
+func (g *Generator) ObserveDTD(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	p := &dtdParser{
+		builder:           newSchemaBuilder(g),
+		parameterEntities: make(map[string]string),
+	}
+	if err := p.parse(string(data)); err != nil {
+		return err
+	}
+	return p.builder.finish()
+}
+
+// parse parses the declarations in s.
+func (p *dtdParser) parse(s string) error {
+	for {
+		i := strings.IndexByte(s, '<')
+		if i == -1 {
+			return nil
+		}
+		s = s[i:]
+		switch {
+		case strings.HasPrefix(s, "<!--"):
+			end := strings.Index(s, "-->")
+			if end == -1 {
+				return errUnexpectedEndOfDTD
+			}
+			s = s[end+len("-->"):]
+		case strings.HasPrefix(s, "<?"):
+			end := strings.Index(s, "?>")
+			if end == -1 {
+				return errUnexpectedEndOfDTD
+			}
+			s = s[end+len("?>"):]
+		case strings.HasPrefix(s, "<!["):
+			return errors.New("conditional sections are not supported")
+		case strings.HasPrefix(s, "<!"):
+			rest := skipMarkupDecl(s[len("<!"):])
+			decl := strings.TrimSuffix(s[len("<!"):len(s)-len(rest)], ">")
+			if err := p.parseDecl(decl); err != nil {
+				return err
+			}
+			s = rest
+		default:
+			s = s[1:]
+		}
+	}
+}
+
+// parseDecl parses the markup declaration decl, without its surrounding <! and
+// >.
+func (p *dtdParser) parseDecl(decl string) error {
+	keyword, rest := decl, ""
+	if i := strings.IndexFunc(decl, unicode.IsSpace); i != -1 {
+		keyword, rest = decl[:i], decl[i:]
+	}
+	switch keyword {
+	case "ENTITY":
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+		if !strings.HasPrefix(rest, "%") {
+			return nil
+		}
+		if name, value, _, ok := parseEntityDecl(rest[1:]); ok {
+			if _, exists := p.parameterEntities[name]; !exists {
+				p.parameterEntities[name] = value
+			}
+		}
+		return nil
+	case "ELEMENT":
+		expanded, err := p.expandParameterEntities(rest, 0)
+		if err != nil {
+			return err
+		}
+		return p.parseElementDecl(expanded)
+	case "ATTLIST":
+		expanded, err := p.expandParameterEntities(rest, 0)
+		if err != nil {
+			return err
+		}
+		return p.parseAttlistDecl(expanded)
+	default:
+		return nil
+	}
+}
+
+// expandParameterEntities returns s with references to parameter entities
+// replaced by their values. Quoted literals, like default attribute values, are
+// not expanded.
+func (p *dtdParser) expandParameterEntities(s string, depth int) (string, error) {
+	if !strings.Contains(s, "%") {
+		return s, nil
+	}
+	if depth > maxParameterEntityDepth {
+		return "", errors.New("parameter entities nested too deeply")
+	}
+	var sb strings.Builder
+	for {
+		i := strings.IndexAny(s, "%\"'")
+		if i == -1 {
+			sb.WriteString(s)
+			break
+		}
+		if s[i] != '%' {
+			end := strings.IndexByte(s[i+1:], s[i])
+			if end == -1 {
+				sb.WriteString(s)
+				break
+			}
+			sb.WriteString(s[:i+end+2])
+			s = s[i+end+2:]
+			continue
+		}
+		sb.WriteString(s[:i])
+		end := strings.IndexByte(s[i:], ';')
+		if end == -1 {
+			return "", fmt.Errorf("%s: invalid parameter entity reference", s[i:])
+		}
+		name := s[i+1 : i+end]
+		value, ok := p.parameterEntities[name]
+		if !ok {
+			return "", fmt.Errorf("%%%s;: undefined parameter entity", name)
+		}
+		expandedValue, err := p.expandParameterEntities(value, depth+1)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(" " + expandedValue + " ")
+		s = s[i+end+1:]
+	}
+	return sb.String(), nil
+}
+
+// parseElementDecl parses the element declaration s, after ELEMENT.
+func (p *dtdParser) parseElementDecl(s string) error {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return fmt.Errorf("%s: invalid element declaration", s)
+	}
+	e := p.builder.declareElement(dtdName(fields[0]))
+	contentSpec := strings.Join(fields[1:], "")
+	switch contentSpec {
+	case "EMPTY":
+		return nil
+	case "ANY":
+		e.raw = true
+		return nil
+	}
+	contentModel, err := parseContentModel(contentSpec)
+	if err != nil {
+		return fmt.Errorf("%s: %w", fields[0], err)
+	}
+	if contentModel.charData {
+		e.charDataValue.declare(valueKindString, nil)
+	}
+	p.builder.declareChildElements(e, contentModel.childNames, contentModel.childOccurs)
+	return nil
+}
+
+// parseAttlistDecl parses the attribute list declaration s, after ATTLIST.
+func (p *dtdParser) parseAttlistDecl(s string) error {
+	tokens := dtdTokens(s)
+	if len(tokens) == 0 {
+		return fmt.Errorf("%s: invalid attribute list declaration", s)
+	}
+	e := p.builder.element(dtdName(tokens[0]))
+	tokens = tokens[1:]
+	for len(tokens) > 0 {
+		if len(tokens) < 3 {
+			return fmt.Errorf("%s: invalid attribute definition", strings.Join(tokens, " "))
+		}
+		attrName, attrType, defaultDecl := tokens[0], tokens[1], tokens[2]
+		tokens = tokens[3:]
+		var enumValues []string
+		switch {
+		case attrType == "NOTATION":
+			if len(tokens) == 0 {
+				return fmt.Errorf("%s: invalid attribute definition", attrName)
+			}
+			attrType, defaultDecl, tokens = defaultDecl, tokens[0], tokens[1:]
+			fallthrough
+		case strings.HasPrefix(attrType, "("):
+			enumValues = strings.FieldsFunc(attrType, func(r rune) bool {
+				return r == '(' || r == ')' || r == '|'
+			})
+		}
+		if defaultDecl == "#FIXED" {
+			if len(tokens) == 0 {
+				return fmt.Errorf("%s: invalid attribute definition", attrName)
+			}
+			tokens = tokens[1:]
+		}
+		name := dtdName(attrName)
+		if name.Space == "xmlns" || name == (xml.Name{Local: "xmlns"}) {
+			continue
+		}
+		p.builder.declareAttr(e, name, valueKindString, enumValues, defaultDecl == "#REQUIRED")
+	}
+	return nil
+}
+
+// dtdTokens splits s into names, parenthesized enumerations with whitespace
+// removed, and quoted strings without their quotes.
+func dtdTokens(s string) []string {
+	var tokens []string
+	for {
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		switch {
+		case s == "":
+			return tokens
+		case s[0] == '"' || s[0] == '\'':
+			end := strings.IndexByte(s[1:], s[0])
+			if end == -1 {
+				return append(tokens, s[1:])
+			}
+			tokens = append(tokens, s[1:end+1])
+			s = s[end+2:]
+		case s[0] == '(':
+			end := strings.IndexByte(s, ')')
+			if end == -1 {
+				end = len(s) - 1
+			}
+			tokens = append(tokens, strings.Join(strings.Fields(s[:end+1]), ""))
+			s = s[end+1:]
+		default:
+			end := strings.IndexFunc(s, unicode.IsSpace)
+			if end == -1 {
+				end = len(s)
+			}
+			tokens = append(tokens, s[:end])
+			s = s[end:]
+		}
+	}
+}
+
+// dtdName returns the xml.Name of the DTD name s, which may have a namespace
+// prefix.
+func dtdName(s string) xml.Name {
+	if prefix, local, ok := strings.Cut(s, ":"); ok {
+		return xml.Name{Space: prefix, Local: local}
+	}
+	return xml.Name{Local: s}
+}
+
+// parseContentModel parses the content model s, which has no whitespace.
+func parseContentModel(s string) (*contentModel, error) {
+	contentModel := &contentModel{
+		childOccurs: make(map[xml.Name]occurs),
+	}
+	if strings.HasPrefix(s, "(#PCDATA") {
+		contentModel.charData = true
+		s = strings.TrimPrefix(s, "(#PCDATA")
+		s = strings.TrimSuffix(s, "*")
+		s = strings.TrimSuffix(s, ")")
+		for _, name := range strings.Split(s, "|") {
+			if name == "" {
+				continue
+			}
+			childName := dtdName(name)
+			contentModel.childNames = append(contentModel.childNames, childName)
+			contentModel.childOccurs[childName] = occurs{min: 0, max: manyOccurrences}
+		}
+		return contentModel, nil
+	}
+	childOccurs, rest, err := parseContentParticle(s, contentModel)
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("%s: invalid content model", s)
+	}
+	contentModel.childOccurs = childOccurs
+	return contentModel, nil
+}
+
+// parseContentParticle parses a content particle from the start of s. It
+// returns the number of occurrences of each child element in the particle and
+// the rest of s. The names of child elements are added to contentModel in the
+// order in which they are first seen.
+func parseContentParticle(s string, contentModel *contentModel) (map[xml.Name]occurs, string, error) {
+	var childOccurs map[xml.Name]occurs
+	switch {
+	case s == "":
+		return nil, "", errors.New("invalid content model")
+	case s[0] == '(':
+		s = s[1:]
+		var separator byte
+		for {
+			particleOccurs, rest, err := parseContentParticle(s, contentModel)
+			if err != nil {
+				return nil, "", err
+			}
+			switch {
+			case childOccurs == nil:
+				childOccurs = particleOccurs
+			case separator == ',':
+				childOccurs = sequenceOccurs(childOccurs, particleOccurs)
+			default:
+				childOccurs = choiceOccurs(childOccurs, particleOccurs)
+			}
+			if rest == "" {
+				return nil, "", errors.New("invalid content model")
+			}
+			switch c := rest[0]; {
+			case c == ')':
+				s = rest[1:]
+			case (c == ',' || c == '|') && (separator == 0 || separator == c):
+				separator = c
+				s = rest[1:]
+				continue
+			default:
+				return nil, "", fmt.Errorf("%s: invalid content model", rest)
+			}
+			break
+		}
+	default:
+		end := strings.IndexAny(s, "?*+,|)")
+		if end == -1 {
+			end = len(s)
+		}
+		name := dtdName(s[:end])
+		if !slices.Contains(contentModel.childNames, name) {
+			contentModel.childNames = append(contentModel.childNames, name)
+		}
+		childOccurs = map[xml.Name]occurs{
+			name: {min: 1, max: 1},
+		}
+		s = s[end:]
+	}
+	if s != "" {
+		switch s[0] {
+		case '?':
+			childOccurs = repeatOccurs(childOccurs, 0, 1)
+			s = s[1:]
+		case '*':
+			childOccurs = repeatOccurs(childOccurs, 0, manyOccurrences)
+			s = s[1:]
+		case '+':
+			childOccurs = repeatOccurs(childOccurs, 1, manyOccurrences)
+			s = s[1:]
+		}
+	}
+	return childOccurs, s, nil
+}
+
+// sequenceOccurs returns the occurrences of child elements in the sequence
+// of particles with occurrences a and b.
+func sequenceOccurs(a, b map[xml.Name]occurs) map[xml.Name]occurs {
+	result := maps.Clone(a)
+	for name, bOccurs := range b {
+		aOccurs := result[name]
+		result[name] = occurs{
+			min: min(aOccurs.min+bOccurs.min, manyOccurrences),
+			max: min(aOccurs.max+bOccurs.max, manyOccurrences),
+		}
+	}
+	return result
+}
+
+// choiceOccurs returns the occurrences of child elements in the choice of
+// particles with occurrences a and b. Child elements that are absent in one of
+// the particles occur zero times in it.
+func choiceOccurs(a, b map[xml.Name]occurs) map[xml.Name]occurs {
+	result := make(map[xml.Name]occurs, len(a)+len(b))
+	for name := range a {
+		result[name] = occurs{min: min(a[name].min, b[name].min), max: max(a[name].max, b[name].max)}
+	}
+	for name := range b {
+		result[name] = occurs{min: min(a[name].min, b[name].min), max: max(a[name].max, b[name].max)}
+	}
+	return result
+}
+
+// repeatOccurs returns the occurrences of child elements in a particle with
+// occurrences childOccurs that is repeated at least minRepeats and at most
+// maxRepeats times.
+func repeatOccurs(childOccurs map[xml.Name]occurs, minRepeats, maxRepeats int) map[xml.Name]occurs {
+	result := make(map[xml.Name]occurs, len(childOccurs))
+	for name, o := range childOccurs {
+		result[name] = occurs{
+			min: min(o.min*minRepeats, manyOccurrences),
+			max: min(o.max*maxRepeats, manyOccurrences),
+		}
+	}
+	return result
+}
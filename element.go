@@ -10,16 +10,30 @@ import (
 	"strings"
 )
 
+// Names of the catch-all fields for attributes and elements that were not
+// observed, and of the field for the inner XML of raw elements.
+const (
+	extraAttrsFieldName    = "Extra"
+	extraElementsFieldName = "ExtraElements"
+	innerXMLFieldName      = "InnerXML"
+)
+
 // An element describes an observed XML element, its attributes, chardata, and
 // children.
 type element struct {
+	anyAttrs         bool
+	anyChildElements bool
 	attrValues       map[xml.Name]*value
 	charDataValue    value
 	childElements    map[xml.Name]*element
 	nestedCount      int
 	childOrder       map[xml.Name]int
+	duplicateLangs   bool
 	name             xml.Name
+	nillable         bool
+	observations     int
 	optionalChildren map[xml.Name]struct{}
+	raw              bool
 	repeatedChildren map[xml.Name]struct{}
 	root             bool
 }
@@ -29,6 +43,7 @@ func newElement(name xml.Name) *element {
 	return &element{
 		name:             name,
 		attrValues:       make(map[xml.Name]*value),
+		charDataValue:    value{name: name},
 		childElements:    make(map[xml.Name]*element),
 		childOrder:       make(map[xml.Name]int),
 		optionalChildren: make(map[xml.Name]struct{}),
@@ -36,10 +51,21 @@ func newElement(name xml.Name) *element {
 	}
 }
 
-// observeAttrs updates e's observed attributes with attrs.
-func (e *element) observeAttrs(attrs []xml.Attr, options *observeOptions) {
+// observeAttrs updates e's observed attributes with attrs. path is the path to
+// e from the root element.
+func (e *element) observeAttrs(attrs []xml.Attr, path []pathName, options *observeOptions) {
 	attrCounts := make(map[xml.Name]int)
 	for _, attr := range attrs {
+		attrPath := append(slices.Clip(path), pathName{attr: true, name: options.pathName(attr.Name)})
+		if matchAnyPathPattern(options.excludePaths, attrPath, options.namespacePrefixes) {
+			continue
+		}
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") {
+			if attr.Value == "true" || attr.Value == "1" {
+				e.nillable = true
+			}
+			continue
+		}
 		attrName := options.nameFunc(attr.Name)
 		if attrName == (xml.Name{}) {
 			continue
@@ -48,9 +74,15 @@ func (e *element) observeAttrs(attrs []xml.Attr, options *observeOptions) {
 		attrValue, ok := e.attrValues[attrName]
 		if !ok {
 			attrValue = &value{
+				attr: true,
 				name: attrName,
+				// The attribute was absent from e's earlier occurrences.
+				optional: e.observations > 1,
+				xmlLang:  isXMLLang(attr.Name),
 			}
 			e.attrValues[attrName] = attrValue
+		} else if !isXMLLang(attr.Name) {
+			attrValue.xmlLang = false
 		}
 		attrValue.observe(attr.Value, options)
 	}
@@ -67,12 +99,20 @@ func (e *element) observeAttrs(attrs []xml.Attr, options *observeOptions) {
 }
 
 // observeChildElement updates e's observed chardata and child elements with
-// tokens read from decoder.
-func (e *element) observeChildElement(decoder *xml.Decoder, startElement xml.StartElement, depth int, options *observeOptions) error {
-	if options.topLevelAttributes || depth != 0 {
-		e.observeAttrs(startElement.Attr, options)
+// tokens read from decoder. path is the path to startElement from the root
+// element.
+func (e *element) observeChildElement(decoder *xml.Decoder, startElement xml.StartElement, path []pathName, options *observeOptions) error {
+	e.observations++
+	if options.topLevelAttributes || len(path) > 1 {
+		e.observeAttrs(startElement.Attr, path, options)
+	}
+	if matchAnyPathPattern(options.rawElements, path, options.namespacePrefixes) {
+		e.raw = true
+		return skipElement(decoder, options)
 	}
 	childCounts := make(map[xml.Name]int)
+	childLangs := make(map[xml.Name]map[string]struct{})
+	charDataTokens, observedCharDataTokens := 0, 0
 FOR:
 	for {
 		var token xml.Token
@@ -87,6 +127,14 @@ FOR:
 		}
 		switch token := token.(type) {
 		case xml.StartElement:
+			options.namespacePrefixes.observe(token.Attr)
+			childPath := append(slices.Clip(path), pathName{name: options.pathName(token.Name)})
+			if matchAnyPathPattern(options.excludePaths, childPath, options.namespacePrefixes) {
+				if err := skipElement(decoder, options); err != nil {
+					return err
+				}
+				break
+			}
 			childName := options.nameFunc(token.Name)
 			if childName == (xml.Name{}) {
 				break
@@ -109,24 +157,44 @@ FOR:
 					childElement = newElement(childName)
 				}
 				e.childElements[childName] = childElement
+				// The child was absent from e's earlier occurrences.
+				if e.observations > 1 {
+					e.optionalChildren[childName] = struct{}{}
+				}
 			}
 			if childElement == e {
 				e.nestedCount++
 			}
+			lang := xmlLang(token.Attr)
+			if _, ok := childLangs[childName][lang]; ok {
+				childElement.duplicateLangs = true
+			} else if childLangs[childName] == nil {
+				childLangs[childName] = map[string]struct{}{lang: {}}
+			} else {
+				childLangs[childName][lang] = struct{}{}
+			}
 			if _, ok := e.childOrder[childName]; !ok {
 				e.childOrder[childName] = options.getOrder()
 			}
-			if err := childElement.observeChildElement(decoder, token, depth+1, options); err != nil {
+			if err := childElement.observeChildElement(decoder, token, childPath, options); err != nil {
 				return err
 			}
 		case xml.EndElement:
 			break FOR
 		case xml.CharData:
+			charDataTokens++
 			if trimmedToken := bytes.TrimSpace(token); len(trimmedToken) > 0 {
 				e.charDataValue.observe(string(token), options)
+				observedCharDataTokens++
 			}
 		}
 	}
+	// encoding/xml concatenates all chardata, including whitespace, so the
+	// unmarshalled value differs from the observed values unless there was at
+	// most one chardata token and it was observed.
+	if charDataTokens > 1 || charDataTokens > observedCharDataTokens {
+		e.charDataValue.fragmented = true
+	}
 	for childName, count := range childCounts {
 		if count > 1 {
 			e.repeatedChildren[childName] = struct{}{}
@@ -140,6 +208,32 @@ FOR:
 	return nil
 }
 
+// skipElement reads tokens from decoder until the end of the current element.
+// Unlike encoding/xml.Decoder.Skip, it respects options.useRawToken.
+func skipElement(decoder *xml.Decoder, options *observeOptions) error {
+	for depth := 0; ; {
+		var token xml.Token
+		var err error
+		if options.useRawToken {
+			token, err = decoder.RawToken()
+		} else {
+			token, err = decoder.Token()
+		}
+		if err != nil {
+			return err
+		}
+		switch token.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			if depth == 0 {
+				return nil
+			}
+			depth--
+		}
+	}
+}
+
 // writeGoType writes e's Go type to w.
 func (e *element) writeGoType(w io.Writer, options *generateOptions, indentPrefix string) error {
 	if options.compactTypes && e.isContainer() {
@@ -151,7 +245,7 @@ func (e *element) writeGoType(w io.Writer, options *generateOptions, indentPrefi
 		}
 	}
 
-	if len(e.attrValues) == 0 && len(e.childElements) == 0 && (!e.root || !options.namedRoot) {
+	if len(e.attrValues) == 0 && len(e.childElements) == 0 && !e.raw && !e.hasWildcards() && (!e.root || !options.namedRoot) {
 		fmt.Fprintf(w, "%s", e.charDataValue.goType(options))
 		return nil
 	}
@@ -160,30 +254,85 @@ func (e *element) writeGoType(w io.Writer, options *generateOptions, indentPrefi
 
 	fieldNames := make(map[string]struct{})
 
+	// resolveFieldName returns the name of the field for the attribute or
+	// child element name, renaming it if it conflicts with an existing field.
+	// Renamed fields with a namespace get the namespace in their xml tag so
+	// that they can be distinguished when unmarshalling.
+	qualifiedNames := make(map[fieldKey]struct{})
+	resolveFieldName := func(name xml.Name, attr bool, defaultName, suffix string) (string, error) {
+		fieldName := options.fieldName(e, name, attr, defaultName)
+		conflict := NameConflict{
+			Element: e.name,
+			Name:    name,
+			Attr:    attr,
+			OldName: fieldName,
+		}
+		resolvedFieldName, err := options.resolveName(fieldNames, "field", conflict, name.Space, suffix)
+		if err != nil {
+			return "", err
+		}
+		if resolvedFieldName != fieldName {
+			key := fieldKey{parent: e, attr: attr, name: name}
+			options.fieldRenames[key] = resolvedFieldName
+			if name.Space != "" {
+				qualifiedNames[key] = struct{}{}
+			}
+		}
+		return resolvedFieldName, nil
+	}
+
 	attrValuesByExportedName := make(map[string]*value, len(e.attrValues))
-	for attrName, attrValue := range e.attrValues {
-		exportedAttrName := options.exportNameFunc(attrName) + options.attrNameSuffix
-		if _, ok := fieldNames[exportedAttrName]; ok {
-			return fmt.Errorf("%s: duplicate field name", exportedAttrName)
+	for _, attrName := range slices.SortedFunc(maps.Keys(e.attrValues), compareNames) {
+		exportedAttrName, err := resolveFieldName(attrName, true, options.exportNameFunc(attrName)+options.attrNameSuffix, "Attr")
+		if err != nil {
+			return err
 		}
-		fieldNames[exportedAttrName] = struct{}{}
-		attrValuesByExportedName[exportedAttrName] = attrValue
+		attrValuesByExportedName[exportedAttrName] = e.attrValues[attrName]
 	}
 	if e.root && options.namedRoot {
-		fmt.Fprintf(w, "%s\tXMLName xml.Name `xml:\"%s\"`\n", indentPrefix, e.name.Local)
+		fmt.Fprintf(w, "%s\tXMLName xml.Name %s\n", indentPrefix, options.structTag(e.name.Local, "", false))
 	}
 	for _, exportedAttrName := range slices.Sorted(maps.Keys(attrValuesByExportedName)) {
 		attrValue := attrValuesByExportedName[exportedAttrName]
-		fmt.Fprintf(w, "%s\t%s %s `xml:\"%s,attr\"`\n", indentPrefix, exportedAttrName, attrValue.goType(options), attrValue.name.Local)
+		if options.fieldComments {
+			writeFieldComment(w, indentPrefix, attrValue, e.observations)
+		}
+		goType := attrValue.goType(options)
+		writeDecimalComment(w, indentPrefix, attrValue, goType, options)
+		xmlTag := attrValue.name.Local
+		if _, ok := qualifiedNames[fieldKey{parent: e, attr: true, name: attrValue.name}]; ok {
+			xmlTag = attrValue.name.Space + " " + xmlTag
+		}
+		fmt.Fprintf(w, "%s\t%s %s %s\n", indentPrefix, exportedAttrName, goType, options.structTag(xmlTag+",attr", attrValue.name.Local, attrValue.optional))
+	}
+	if options.catchAll || e.anyAttrs {
+		fieldName, err := options.resolveName(fieldNames, "field", NameConflict{Element: e.name, OldName: extraAttrsFieldName}, "", "")
+		if err != nil {
+			return err
+		}
+		options.importPackageNames["encoding/xml"] = struct{}{}
+		fmt.Fprintf(w, "%s\t%s []xml.Attr %s\n", indentPrefix, fieldName, options.structTag(",any,attr", "", false))
+	}
+
+	if e.raw {
+		fieldName, err := options.resolveName(fieldNames, "field", NameConflict{Element: e.name, OldName: innerXMLFieldName}, "", "")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s\t%s string %s\n", indentPrefix, fieldName, options.structTag(",innerxml", innerXMLFieldName, false))
+		fmt.Fprintf(w, "%s}", indentPrefix)
+		return nil
 	}
 
 	if e.charDataValue.observations > 0 {
-		fieldName := options.charDataFieldName
-		if _, ok := fieldNames[fieldName]; ok {
-			return fmt.Errorf("%s: duplicate field name", fieldName)
+		fieldName, err := resolveFieldName(xml.Name{}, false, options.charDataFieldName, "")
+		if err != nil {
+			return err
+		}
+		if options.fieldComments {
+			writeFieldComment(w, indentPrefix, &e.charDataValue, e.observations)
 		}
-		fieldNames[fieldName] = struct{}{}
-		fmt.Fprintf(w, "%s\t%s string `xml:\",chardata\"`\n", indentPrefix, fieldName)
+		fmt.Fprintf(w, "%s\t%s string %s\n", indentPrefix, fieldName, options.structTag(",chardata", fieldName, false))
 	}
 
 	childElements := slices.Collect(maps.Values(e.childElements))
@@ -193,8 +342,8 @@ func (e *element) writeGoType(w io.Writer, options *generateOptions, indentPrefi
 		})
 	} else {
 		slices.SortFunc(childElements, func(a, b *element) int {
-			aExportedName := exportedNameWithoutSuffix(a, options)
-			bExportedName := exportedNameWithoutSuffix(b, options)
+			aExportedName := options.fieldName(e, a.name, false, options.repeatedChildName(e, a, exportedNameWithoutSuffix(a, options)))
+			bExportedName := options.fieldName(e, b.name, false, options.repeatedChildName(e, b, exportedNameWithoutSuffix(b, options)))
 			switch {
 			case aExportedName < bExportedName:
 				return -1
@@ -206,7 +355,7 @@ func (e *element) writeGoType(w io.Writer, options *generateOptions, indentPrefi
 				case aOriginalName < bOriginalName:
 					return -1
 				case aOriginalName == bOriginalName:
-					return 0
+					return compareNames(a.name, b.name)
 				default:
 					return 1
 				}
@@ -227,12 +376,15 @@ func (e *element) writeGoType(w io.Writer, options *generateOptions, indentPrefi
 		if !shouldCompact {
 			elementOptions.compactTypes = false
 		}
-		exportedChildName := exportedName(childElement, &elementOptions)
+		exportedChildName := options.fieldName(e, childElement.name, false, options.repeatedChildName(e, childElement, exportedNameWithoutSuffix(childElement, &elementOptions))+options.elemNameSuffix)
 
-		if _, ok := fieldNames[exportedChildName]; ok {
-			// Only report field name conflicts if we're not using named types for this element
-			if _, hasNamedType := options.namedTypes[childElement.name]; !hasNamedType {
-				return fmt.Errorf("%s: duplicate field name", exportedChildName)
+		// Only report field name conflicts if we're not using named types for
+		// this element, unless they are being resolved.
+		if _, hasNamedType := options.namedTypes[childElement.name]; !hasNamedType || options.nameConflictStrategy != NameConflictStrategyError {
+			var err error
+			exportedChildName, err = resolveFieldName(childElement.name, false, exportedChildName, "Elem")
+			if err != nil {
+				return err
 			}
 		}
 		fieldNames[exportedChildName] = struct{}{}
@@ -249,6 +401,22 @@ func (e *element) writeGoType(w io.Writer, options *generateOptions, indentPrefi
 			}
 		}
 
+		currentChild := childElement
+		if shouldCompact {
+			currentChild = firstNotContainerElement(childElement)
+		}
+
+		if repeated && options.langMaps && currentChild.isLangVariant() {
+			fmt.Fprintf(w, "%s\t%s %s %s\n", indentPrefix, exportedChildName, options.addDecl(langMapDecl), options.structTag(attrName(childElement, shouldCompact), currentChild.name.Local, optional))
+			continue
+		}
+
+		if len(currentChild.attrValues) == 0 && len(currentChild.childElements) == 0 {
+			if options.fieldComments {
+				writeFieldComment(w, indentPrefix, &currentChild.charDataValue, currentChild.observations)
+			}
+			writeDecimalComment(w, indentPrefix, &currentChild.charDataValue, currentChild.charDataValue.goType(options), options)
+		}
 		fmt.Fprintf(w, "%s\t%s ", indentPrefix, exportedChildName)
 		if repeated {
 			fmt.Fprintf(w, "[]")
@@ -257,29 +425,106 @@ func (e *element) writeGoType(w io.Writer, options *generateOptions, indentPrefi
 				fmt.Fprintf(w, "*")
 			}
 		}
-
-		currentChild := childElement
-		if shouldCompact {
-			currentChild = firstNotContainerElement(childElement)
+		if currentChild.nillable {
+			fmt.Fprintf(w, "%s[", options.addDecl(nillableDecl))
 		}
+
+		// encoding/xml never considers struct values to be empty, so omitempty
+		// has no effect on fields whose type is a struct rather than a slice or
+		// a pointer.
+		structType := currentChild.nillable
 		if topLevelElement, ok := options.namedTypes[currentChild.name]; ok {
-			fmt.Fprintf(w, "%s", options.exportTypeNameFunc(topLevelElement.name))
-		} else if _, ok := options.simpleTypes[currentChild.name]; ok {
-			fmt.Fprintf(w, "%s", currentChild.charDataValue.goType(options))
+			fmt.Fprintf(w, "%s", options.typeName(topLevelElement))
+			structType = true
 		} else {
-			if err := currentChild.writeGoType(w, options, indentPrefix+"\t"); err != nil {
+			goType := &strings.Builder{}
+			if _, ok := options.simpleTypes[currentChild.name]; ok {
+				goType.WriteString(currentChild.charDataValue.goType(options))
+			} else if err := currentChild.writeGoType(goType, options, indentPrefix+"\t"); err != nil {
 				return err
 			}
+			fmt.Fprintf(w, "%s", goType)
+			structType = structType || strings.HasPrefix(goType.String(), "struct")
+		}
+		if currentChild.nillable {
+			fmt.Fprintf(w, "]")
+		}
+		xmlTag := attrName(childElement, shouldCompact)
+		if _, ok := qualifiedNames[fieldKey{parent: e, name: childElement.name}]; ok && !shouldCompact {
+			xmlTag = childElement.name.Space + " " + xmlTag
 		}
-		fmt.Fprintf(w, " `xml:\"%s\"`\n", attrName(childElement, shouldCompact))
+		omittable := optional && (repeated || options.usePointersForOptionalFields || !structType)
+		fmt.Fprintf(w, " %s\n", options.structTag(xmlTag, currentChild.name.Local, omittable))
+	}
+
+	if options.catchAll || e.anyChildElements {
+		fieldName, err := options.resolveName(fieldNames, "field", NameConflict{Element: e.name, OldName: extraElementsFieldName}, "", "")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%s\t%s []%s %s\n", indentPrefix, fieldName, options.addDecl(anyElementDecl), options.structTag(",any", "", false))
 	}
 
 	fmt.Fprintf(w, "%s}", indentPrefix)
 	return nil
 }
 
+// writeFieldComment writes a comment describing the example values of v, which
+// was observed out of total times, to w.
+func writeFieldComment(w io.Writer, indentPrefix string, v *value, total int) {
+	if fieldComment := v.fieldComment(total); fieldComment != "" {
+		fmt.Fprintf(w, "%s\t%s\n", indentPrefix, fieldComment)
+	}
+}
+
+// writeDecimalComment writes a comment with the precision and scale observed
+// for v, whose Go type is goType, if they may not be preserved.
+func writeDecimalComment(w io.Writer, indentPrefix string, v *value, goType string, options *generateOptions) {
+	if decimalComment := v.decimalComment(goType, options); decimalComment != "" {
+		fmt.Fprintf(w, "%s\t%s\n", indentPrefix, decimalComment)
+	}
+}
+
+// isXMLLang returns whether name, before it is converted by the name function,
+// is xml:lang.
+func isXMLLang(name xml.Name) bool {
+	return name.Local == "lang" && (name.Space == "xml" || name.Space == xmlNamespace)
+}
+
+// xmlLang returns the value of the xml:lang attribute in attrs, or the empty
+// string if there is none.
+func xmlLang(attrs []xml.Attr) string {
+	for _, attr := range attrs {
+		if isXMLLang(attr.Name) {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// isLangVariant returns whether e has no attributes other than xml:lang and no
+// child elements, and no two siblings had the same xml:lang, so that repeated
+// occurrences of e differ only by language.
+func (e *element) isLangVariant() bool {
+	if e.duplicateLangs || len(e.attrValues) != 1 || len(e.childElements) != 0 {
+		return false
+	}
+	for _, attrValue := range e.attrValues {
+		if !attrValue.xmlLang {
+			return false
+		}
+	}
+	return true
+}
+
+// hasWildcards returns whether e has attributes or child elements whose names
+// are not known, because they are declared by wildcards in a schema.
+func (e *element) hasWildcards() bool {
+	return e.anyAttrs || e.anyChildElements
+}
+
 func (e *element) isContainer() bool {
-	return len(e.childElements) == 1 && len(e.attrValues) == 0 && e.charDataValue.observations == 0
+	return len(e.childElements) == 1 && len(e.attrValues) == 0 && e.charDataValue.observations == 0 && !e.hasWildcards()
 }
 
 func firstNotContainerElement(el *element) *element {
@@ -0,0 +1,169 @@
+package xmlstruct
+
+import (
+	"cmp"
+	"encoding/xml"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// observeDirective adds the entities declared in directive to decoder, so
+// that references to them in the rest of the document are replaced, and to
+// options. Entities already known to decoder are not replaced.
+func observeDirective(decoder *xml.Decoder, directive string, options *observeOptions) {
+	entities := parseEntityDecls(directive)
+	if len(entities) == 0 {
+		return
+	}
+	decoderEntities := make(map[string]string, len(decoder.Entity)+len(entities))
+	maps.Copy(decoderEntities, entities)
+	maps.Copy(decoderEntities, decoder.Entity)
+	decoder.Entity = decoderEntities
+	for _, name := range slices.Sorted(maps.Keys(entities)) {
+		if _, ok := options.entities[entities[name]]; !ok {
+			options.entities[entities[name]] = name
+		}
+	}
+}
+
+// parseEntityDecls returns the internal general entities declared in the
+// internal subset of the DOCTYPE directive, as a map of entity names to
+// replacement text. Parameter entities, external entities, and malformed
+// declarations are ignored.
+func parseEntityDecls(directive string) map[string]string {
+	if !strings.HasPrefix(directive, "DOCTYPE") {
+		return nil
+	}
+	start := strings.IndexByte(directive, '[')
+	if start == -1 {
+		return nil
+	}
+	entities := make(map[string]string)
+	s := directive[start+1:]
+	for {
+		i := strings.IndexByte(s, '<')
+		if i == -1 {
+			return entities
+		}
+		s = s[i:]
+		switch {
+		case strings.HasPrefix(s, "<!--"):
+			end := strings.Index(s, "-->")
+			if end == -1 {
+				return entities
+			}
+			s = s[end+len("-->"):]
+		case strings.HasPrefix(s, "<!ENTITY"):
+			var name, value string
+			var ok bool
+			name, value, s, ok = parseEntityDecl(s[len("<!ENTITY"):])
+			if ok {
+				if _, exists := entities[name]; !exists {
+					entities[name] = value
+				}
+			}
+		default:
+			s = skipMarkupDecl(s[1:])
+		}
+	}
+}
+
+// parseEntityDecl parses the remainder of an entity declaration after
+// <!ENTITY from s. It returns the entity's name and replacement text, the rest
+// of s after the declaration, and whether the declaration declares an internal
+// general entity.
+func parseEntityDecl(s string) (string, string, string, bool) {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return r == '>' || r == '"' || r == '\'' || unicode.IsSpace(r)
+	})
+	if i <= 0 || s[0] == '%' {
+		return "", "", skipMarkupDecl(s), false
+	}
+	name, rest := s[:i], strings.TrimLeftFunc(s[i:], unicode.IsSpace)
+	if rest == "" || rest[0] != '"' && rest[0] != '\'' {
+		return "", "", skipMarkupDecl(rest), false
+	}
+	end := strings.IndexByte(rest[1:], rest[0])
+	if end == -1 {
+		return "", "", "", false
+	}
+	value := rest[1 : end+1]
+	return name, value, skipMarkupDecl(rest[end+2:]), true
+}
+
+// skipMarkupDecl returns s after the end of the current markup declaration,
+// skipping quoted strings.
+func skipMarkupDecl(s string) string {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"', '\'':
+			end := strings.IndexByte(s[i+1:], s[i])
+			if end == -1 {
+				return ""
+			}
+			i += end + 1
+		case '>':
+			return s[i+1:]
+		}
+	}
+	return ""
+}
+
+// addEntityEnum records that the values of v are the replacement texts of
+// entities and returns the name of the enumerated type for them.
+func (o *generateOptions) addEntityEnum(v *value) string {
+	typeName := o.declName(o.exportNameFunc(v.name) + "Entity")
+	entityEnum, ok := o.entityEnumValues[typeName]
+	if !ok {
+		entityEnum = make(map[string]string)
+		o.entityEnumValues[typeName] = entityEnum
+	}
+	maps.Copy(entityEnum, v.entityNames)
+	return typeName
+}
+
+// addEntityEnumDecls adds the declarations of the enumerated types of entity
+// values.
+func (o *generateOptions) addEntityEnumDecls() {
+	for typeName, entityEnum := range o.entityEnumValues {
+		constNames := make(map[string]struct{})
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "// A %s is the replacement text of an entity.\n", typeName)
+		fmt.Fprintf(&sb, "type %s string\n", typeName)
+		fmt.Fprintf(&sb, "\n")
+		fmt.Fprintf(&sb, "// %s values.\n", typeName)
+		fmt.Fprintf(&sb, "const (\n")
+		// Values of the same entity differ only in surrounding whitespace, so
+		// sort the shortest, the replacement text itself, first.
+		values := slices.SortedFunc(maps.Keys(entityEnum), func(a, b string) int {
+			return cmp.Or(
+				cmp.Compare(entityEnum[a], entityEnum[b]),
+				cmp.Compare(len(a), len(b)),
+				cmp.Compare(a, b),
+			)
+		})
+		for _, value := range values {
+			constName := typeName + DefaultExportNameFunc(xml.Name{Local: entityEnum[value]})
+			if _, ok := constNames[constName]; ok {
+				for i := 2; ; i++ {
+					if _, ok := constNames[constName+strconv.Itoa(i)]; !ok {
+						constName += strconv.Itoa(i)
+						break
+					}
+				}
+			}
+			constNames[constName] = struct{}{}
+			fmt.Fprintf(&sb, "\t%s %s = %q\n", constName, typeName, value)
+		}
+		fmt.Fprintf(&sb, ")\n")
+		o.addDecl(&decl{
+			name:   typeName,
+			source: sb.String(),
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package xmlstruct
 
 import (
+	"cmp"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -8,6 +9,7 @@ import (
 	"io"
 	"io/fs"
 	"maps"
+	"math/rand/v2"
 	"os"
 	"slices"
 	"strings"
@@ -28,29 +30,57 @@ type ModifyDecoderFunc func(*xml.Decoder)
 // A Generator observes XML documents and generates Go structs into which the
 // XML documents can be unmarshalled.
 type Generator struct {
+	asciiIdentifiers             bool
 	attrNameSuffix               string
+	bigIntType                   string
+	binaryMinLength              int
+	catchAll                     bool
 	charDataFieldName            string
+	decimalType                  string
 	elemNameSuffix               string
+	entityEnums                  bool
 	exportNameFunc               ExportNameFunc
 	exportTypeNameFunc           ExportNameFunc
+	excludePaths                 []string
 	exportRenames                map[string]string
+	falseValues                  []string
+	fieldComments                bool
 	formatSource                 bool
 	header                       string
+	htmlMode                     bool
 	imports                      bool
 	intType                      string
+	langMaps                     bool
 	modifyDecoderFunc            ModifyDecoderFunc
 	nameFunc                     NameFunc
+	nameConflictStrategy         NameConflictStrategy
+	nameConflicts                []NameConflict
 	namedRoot                    bool
 	namedTypes                   bool
 	compactTypes                 bool
+	omitEmpty                    bool
 	order                        int
+	outliers                     []Outlier
 	packageName                  string
+	pathRenames                  map[string]string
+	pluralOverrides              map[string]string
+	pluralizeRepeated            bool
+	preserveLeadingZeros         bool
 	preserveOrder                bool
+	rand                         *rand.Rand
+	rawElements                  []string
+	sizedIntTypes                bool
+	stringSubtypes               bool
+	tagNameCase                  TagNameCase
+	tags                         []string
 	timeLayout                   string
 	topLevelAttributes           bool
+	trueValues                   []string
+	typeConfidence               float64
 	typeOrder                    map[xml.Name]int
 	usePointersForOptionalFields bool
 	useRawToken                  bool
+	validateMethods              bool
 	typeElements                 map[xml.Name]*element
 	emptyElements                bool
 }
@@ -58,6 +88,16 @@ type Generator struct {
 // A GeneratorOption sets an option on a Generator.
 type GeneratorOption func(*Generator)
 
+// WithASCIIIdentifiers sets whether to transliterate element and attribute
+// names to ASCII before converting them to Go identifiers, for example Straße
+// to Strasse. Runes that cannot be transliterated are escaped by their code
+// point. Export renames apply to the transliterated names.
+func WithASCIIIdentifiers(asciiIdentifiers bool) GeneratorOption {
+	return func(g *Generator) {
+		g.asciiIdentifiers = asciiIdentifiers
+	}
+}
+
 // WithAttrNameSuffix sets the attribute suffix.
 func WithAttrNameSuffix(attrSuffix string) GeneratorOption {
 	return func(g *Generator) {
@@ -65,6 +105,46 @@ func WithAttrNameSuffix(attrSuffix string) GeneratorOption {
 	}
 }
 
+// WithBigIntType sets the type used for integers that do not fit in 64 bits
+// when sized int types are enabled. It is either "*big.Int", "string", or a type
+// qualified by its import path, like "*github.com/example/bigint.Int".
+func WithBigIntType(bigIntType string) GeneratorOption {
+	return func(g *Generator) {
+		g.bigIntType = bigIntType
+	}
+}
+
+// WithBinaryMinLength sets the minimum length of values that are considered
+// for detection as hex- or base64-encoded binary data. Values where all
+// observations are binary data are generated as a []byte type with the
+// appropriate text marshalers. Zero disables binary detection.
+func WithBinaryMinLength(binaryMinLength int) GeneratorOption {
+	return func(g *Generator) {
+		g.binaryMinLength = binaryMinLength
+	}
+}
+
+// WithBoolValues sets additional spellings of bools, for example "yes" and "no".
+// trueValues[i] and falseValues[i] are the true and false spellings of the ith
+// pair, so trueValues and falseValues must have the same length. Values where
+// all observations are spellings from the same pair are generated as a bool
+// type that preserves the spelling.
+func WithBoolValues(trueValues, falseValues []string) GeneratorOption {
+	return func(g *Generator) {
+		g.trueValues = trueValues
+		g.falseValues = falseValues
+	}
+}
+
+// WithCatchAll sets whether to add catch-all fields to each struct for
+// attributes and elements that were not observed, so that they are preserved
+// when unmarshalling and marshalling.
+func WithCatchAll(catchAll bool) GeneratorOption {
+	return func(g *Generator) {
+		g.catchAll = catchAll
+	}
+}
+
 // WithCharDataFieldName sets the char data field name.
 func WithCharDataFieldName(charDataFieldName string) GeneratorOption {
 	return func(g *Generator) {
@@ -72,6 +152,26 @@ func WithCharDataFieldName(charDataFieldName string) GeneratorOption {
 	}
 }
 
+// WithDecimalType sets the type used for decimal numbers. It is either an
+// unqualified name, like "Decimal", in which case a string-backed type that
+// preserves the exact digits is generated, or a type qualified by its import
+// path, like "github.com/shopspring/decimal.Decimal", in which case fields are
+// commented with the maximum precision and scale observed, so that values can
+// be formatted with the same digits. An empty string uses float64.
+func WithDecimalType(decimalType string) GeneratorOption {
+	return func(g *Generator) {
+		g.decimalType = decimalType
+	}
+}
+
+// WithFieldComments sets whether to write a comment above each field with
+// example values and the number of times that they were observed.
+func WithFieldComments(fieldComments bool) GeneratorOption {
+	return func(g *Generator) {
+		g.fieldComments = fieldComments
+	}
+}
+
 // WithElemNameSuffix sets the element name suffix.
 func WithElemNameSuffix(elemNameSuffix string) GeneratorOption {
 	return func(g *Generator) {
@@ -87,6 +187,26 @@ func WithEmptyElements(emptyElements bool) GeneratorOption {
 	}
 }
 
+// WithEntityEnums sets whether to generate enumerated types for values that
+// are always the replacement text of an entity declared in the document's
+// DTD internal subset. The constants are named after the entities.
+func WithEntityEnums(entityEnums bool) GeneratorOption {
+	return func(g *Generator) {
+		g.entityEnums = entityEnums
+	}
+}
+
+// WithExcludePaths sets patterns of elements and attributes that are not
+// observed. Patterns are either names, which match anywhere, or XPath-like
+// paths like /gpx/trk/*/extensions, where * matches any name or namespace
+// prefix, // matches any descendant, and a final step prefixed with @ matches
+// attributes. For example, //@xmlns:* excludes all namespace declarations.
+func WithExcludePaths(excludePaths []string) GeneratorOption {
+	return func(g *Generator) {
+		g.excludePaths = excludePaths
+	}
+}
+
 // WithExportNameFunc sets the export name function for the generated Go source.
 // It overrides WithExportRenames.
 func WithExportNameFunc(exportNameFunc ExportNameFunc) GeneratorOption {
@@ -125,6 +245,18 @@ func WithHeader(header string) GeneratorOption {
 	}
 }
 
+// WithHTMLMode sets whether to observe HTML-like documents. The decoder is not
+// strict, automatically closes HTML void elements, recognizes HTML entities,
+// and element and attribute names are converted to lower case, including when
+// matching exclude paths and raw elements. As encoding/xml matches names
+// case-sensitively, the observed documents must be unmarshalled with a decoder
+// returned by NewHTMLDecoder.
+func WithHTMLMode(htmlMode bool) GeneratorOption {
+	return func(g *Generator) {
+		g.htmlMode = htmlMode
+	}
+}
+
 // WithImports sets whether to include an import statement in the generated code.
 func WithImports(withImports bool) GeneratorOption {
 	return func(g *Generator) {
@@ -139,6 +271,15 @@ func WithIntType(intType string) GeneratorOption {
 	}
 }
 
+// WithLangMaps sets whether to generate a LangMap for repeated elements that
+// are distinguished only by their xml:lang attribute. Elements that were
+// repeated with the same xml:lang, or without one, keep their slice type.
+func WithLangMaps(langMaps bool) GeneratorOption {
+	return func(g *Generator) {
+		g.langMaps = langMaps
+	}
+}
+
 // WithModifyDecoderFunc sets the function that will modify the
 // encoding/xml.Decoder used.
 func WithModifyDecoderFunc(modifyDecoderFunc ModifyDecoderFunc) GeneratorOption {
@@ -154,6 +295,17 @@ func WithNameFunc(nameFunc NameFunc) GeneratorOption {
 	}
 }
 
+// WithNameConflictStrategy sets how duplicate field and type names are
+// resolved. The default, [NameConflictStrategyError], returns an error.
+// Helper types, like Email, whose names are already used by element types are
+// always renamed with a number. Resolved duplicates are reported by
+// [Generator.NameConflicts].
+func WithNameConflictStrategy(nameConflictStrategy NameConflictStrategy) GeneratorOption {
+	return func(g *Generator) {
+		g.nameConflictStrategy = nameConflictStrategy
+	}
+}
+
 // WithNamedRoot sets whether to generate an XMLName field for the root element.
 func WithNamedRoot(namedRoot bool) GeneratorOption {
 	return func(o *Generator) {
@@ -175,6 +327,18 @@ func WithCompactTypes(compactTypes bool) GeneratorOption {
 	}
 }
 
+// WithOmitEmpty sets whether to add omitempty to the xml struct tags of
+// optional attributes and elements, so that marshalling does not add empty
+// attributes and elements that were not present in the observed documents.
+// encoding/xml always marshals struct values, so elements whose fields are
+// structs rather than pointers, see WithUsePointersForOptionalFields, do not
+// get omitempty.
+func WithOmitEmpty(omitEmpty bool) GeneratorOption {
+	return func(g *Generator) {
+		g.omitEmpty = omitEmpty
+	}
+}
+
 // WithPackageName sets the package name of the generated Go source.
 func WithPackageName(packageName string) GeneratorOption {
 	return func(g *Generator) {
@@ -182,6 +346,44 @@ func WithPackageName(packageName string) GeneratorOption {
 	}
 }
 
+// WithPathRenames sets the Go names of the fields and named types of the
+// elements and attributes that match the given path patterns, for example
+// "/rss/channel/item/title" to "Headline". Patterns are as for
+// WithExcludePaths, except that namespace prefixes match the namespace of the
+// names returned by the name function. If more than one pattern matches then
+// the first in lexical order is used.
+func WithPathRenames(pathRenames map[string]string) GeneratorOption {
+	return func(g *Generator) {
+		g.pathRenames = pathRenames
+	}
+}
+
+// WithPluralOverrides sets the plurals of field names used instead of the
+// built-in English inflector when pluralizing repeated elements, for example
+// {"Wpt": "Waypoints"}.
+func WithPluralOverrides(pluralOverrides map[string]string) GeneratorOption {
+	return func(g *Generator) {
+		g.pluralOverrides = pluralOverrides
+	}
+}
+
+// WithPluralizeRepeated sets whether to pluralize the field names of repeated
+// elements, for example Items instead of Item. The xml tags are unchanged.
+func WithPluralizeRepeated(pluralizeRepeated bool) GeneratorOption {
+	return func(g *Generator) {
+		g.pluralizeRepeated = pluralizeRepeated
+	}
+}
+
+// WithPreserveLeadingZeros sets whether to use strings for integer values
+// whose formatting would not survive a round trip, for example codes with
+// leading zeros like 007.
+func WithPreserveLeadingZeros(preserveLeadingZeros bool) GeneratorOption {
+	return func(g *Generator) {
+		g.preserveLeadingZeros = preserveLeadingZeros
+	}
+}
+
 // WithPreserveOrder sets whether to preserve the order of types and fields.
 func WithPreserveOrder(preserveOrder bool) GeneratorOption {
 	return func(g *Generator) {
@@ -189,6 +391,52 @@ func WithPreserveOrder(preserveOrder bool) GeneratorOption {
 	}
 }
 
+// WithRawElements sets patterns of elements whose contents are not observed.
+// Matching elements are generated as structs with their attributes and an
+// InnerXML field containing their raw inner XML. Patterns are either element
+// names, which match anywhere, or XPath-like paths like
+// /gpx/trk/trkseg/trkpt/extensions, where * matches any name and // matches
+// any descendant. With WithNamedTypes, all elements with the same name share a
+// type, so only element names are allowed.
+func WithRawElements(rawElements []string) GeneratorOption {
+	return func(g *Generator) {
+		g.rawElements = rawElements
+	}
+}
+
+// WithSizedIntTypes sets whether to use the narrowest integer type, for
+// example uint8 or int16, that can represent the observed range of values. It
+// overrides WithIntType.
+func WithSizedIntTypes(sizedIntTypes bool) GeneratorOption {
+	return func(g *Generator) {
+		g.sizedIntTypes = sizedIntTypes
+	}
+}
+
+// WithStringSubtypes sets whether to generate URL, UUID, and Email types for
+// values where all observations are absolute URLs, UUIDs, or email addresses.
+func WithStringSubtypes(stringSubtypes bool) GeneratorOption {
+	return func(g *Generator) {
+		g.stringSubtypes = stringSubtypes
+	}
+}
+
+// WithTagNameCase sets the case of names in the struct tags added by
+// WithTags.
+func WithTagNameCase(tagNameCase TagNameCase) GeneratorOption {
+	return func(g *Generator) {
+		g.tagNameCase = tagNameCase
+	}
+}
+
+// WithTags sets additional struct tags, for example "json" and "yaml", to
+// generate alongside the xml struct tags. Optional fields get omitempty.
+func WithTags(tags []string) GeneratorOption {
+	return func(g *Generator) {
+		g.tags = tags
+	}
+}
+
 // WithTimeLayout sets the time layout used to identify times in the observed
 // XML documents. Use an empty string to disable identifying times.
 func WithTimeLayout(timeLayout string) GeneratorOption {
@@ -204,6 +452,16 @@ func WithTopLevelAttributes(topLevelAttributes bool) GeneratorOption {
 	}
 }
 
+// WithTypeConfidence sets the fraction of observations that must agree on a
+// type for it to be chosen. Values that disagree with the chosen type are
+// reported as outliers by [Generator.Outliers]. It must be greater than 0 and
+// at most 1. The default of 1 requires all observations to agree.
+func WithTypeConfidence(typeConfidence float64) GeneratorOption {
+	return func(g *Generator) {
+		g.typeConfidence = typeConfidence
+	}
+}
+
 // WithUsePointersForOptionFields sets whether to use pointers for optional
 // fields in the generated Go source.
 func WithUsePointersForOptionalFields(usePointersForOptionalFields bool) GeneratorOption {
@@ -220,27 +478,57 @@ func WithUseRawToken(useRawToken bool) GeneratorOption {
 	}
 }
 
+// WithValidateMethods sets whether to generate a Validate method for each
+// named struct type. The Validate methods check that required repeated
+// elements are present, that numbers are in the observed range, that strings
+// with few distinct values are one of those values, and that other strings
+// have the observed lengths.
+func WithValidateMethods(validateMethods bool) GeneratorOption {
+	return func(g *Generator) {
+		g.validateMethods = validateMethods
+	}
+}
+
 // NewGenerator returns a new Generator with the given options.
 func NewGenerator(options ...GeneratorOption) *Generator {
 	g := &Generator{
+		asciiIdentifiers:             DefaultASCIIIdentifiers,
 		attrNameSuffix:               DefaultAttrNameSuffix,
+		bigIntType:                   DefaultBigIntType,
+		binaryMinLength:              DefaultBinaryMinLength,
+		catchAll:                     DefaultCatchAll,
 		charDataFieldName:            DefaultCharDataFieldName,
+		decimalType:                  DefaultDecimalType,
 		elemNameSuffix:               DefaultElemNameSuffix,
+		entityEnums:                  DefaultEntityEnums,
+		fieldComments:                DefaultFieldComments,
 		formatSource:                 DefaultFormatSource,
 		header:                       DefaultHeader,
+		htmlMode:                     DefaultHTMLMode,
 		imports:                      DefaultImports,
 		intType:                      DefaultIntType,
+		langMaps:                     DefaultLangMaps,
+		nameConflictStrategy:         DefaultNameConflictStrategy,
 		nameFunc:                     DefaultNameFunc,
 		namedRoot:                    DefaultNamedRoot,
 		namedTypes:                   DefaultNamedTypes,
 		compactTypes:                 DefaultCompactTypes,
+		omitEmpty:                    DefaultOmitEmpty,
 		packageName:                  DefaultPackageName,
+		pluralizeRepeated:            DefaultPluralizeRepeated,
+		preserveLeadingZeros:         DefaultPreserveLeadingZeros,
 		preserveOrder:                DefaultPreserveOrder,
+		rand:                         rand.New(rand.NewPCG(0, 0)),
+		sizedIntTypes:                DefaultSizedIntTypes,
+		stringSubtypes:               DefaultStringSubtypes,
+		tagNameCase:                  DefaultTagNameCase,
 		timeLayout:                   DefaultTimeLayout,
 		topLevelAttributes:           DefaultTopLevelAttributes,
+		typeConfidence:               DefaultTypeConfidence,
 		typeOrder:                    make(map[xml.Name]int),
 		usePointersForOptionalFields: DefaultUsePointersForOptionalFields,
 		useRawToken:                  DefaultUseRawToken,
+		validateMethods:              DefaultValidateMethods,
 		typeElements:                 make(map[xml.Name]*element),
 		emptyElements:                DefaultEmptyElements,
 	}
@@ -256,6 +544,10 @@ func NewGenerator(options ...GeneratorOption) *Generator {
 	if g.exportTypeNameFunc == nil {
 		g.exportTypeNameFunc = g.exportNameFunc
 	}
+	if g.asciiIdentifiers {
+		g.exportNameFunc = asciiExportNameFunc(g.exportNameFunc)
+		g.exportTypeNameFunc = asciiExportNameFunc(g.exportTypeNameFunc)
+	}
 	return g
 }
 
@@ -264,24 +556,84 @@ func NewGenerator(options ...GeneratorOption) *Generator {
 func (g *Generator) Generate() ([]byte, error) {
 	options := generateOptions{
 		attrNameSuffix:               g.attrNameSuffix,
+		bigIntType:                   g.bigIntType,
+		catchAll:                     g.catchAll,
 		charDataFieldName:            g.charDataFieldName,
+		decimalType:                  g.decimalType,
+		declNames:                    make(map[string]string),
+		decls:                        make(map[string]*decl),
 		elemNameSuffix:               g.elemNameSuffix,
+		entityEnumValues:             make(map[string]map[string]string),
+		entityEnums:                  g.entityEnums,
 		exportNameFunc:               g.exportNameFunc,
 		exportTypeNameFunc:           g.exportTypeNameFunc,
+		falseValues:                  g.falseValues,
+		fieldComments:                g.fieldComments,
 		header:                       g.header,
 		importPackageNames:           make(map[string]struct{}),
 		intType:                      g.intType,
+		langMaps:                     g.langMaps,
+		nameConflictStrategy:         g.nameConflictStrategy,
 		namedRoot:                    g.namedRoot,
 		compactTypes:                 g.compactTypes,
+		omitEmpty:                    g.omitEmpty,
+		outliers:                     make(map[*value][]Outlier),
+		pluralOverrides:              g.pluralOverrides,
+		pluralizeRepeated:            g.pluralizeRepeated,
+		preserveLeadingZeros:         g.preserveLeadingZeros,
 		preserveOrder:                g.preserveOrder,
+		sizedIntTypes:                g.sizedIntTypes,
+		tagNameCase:                  g.tagNameCase,
+		tags:                         g.tags,
+		trueValues:                   g.trueValues,
+		typeConfidence:               g.typeConfidence,
 		usePointersForOptionalFields: g.usePointersForOptionalFields,
+		validateMethods:              g.validateMethods,
 		emptyElements:                g.emptyElements,
 	}
 
+	switch options.tagNameCase {
+	case TagNameCaseCamel, TagNameCaseOriginal, TagNameCaseSnake:
+	default:
+		return nil, fmt.Errorf("%s: unknown tag name case", options.tagNameCase)
+	}
+
+	if goType, importPath, ok := qualifiedGoType(options.bigIntType); !ok || importPath == "" && goType != "string" {
+		return nil, fmt.Errorf("%s: invalid big int type", options.bigIntType)
+	}
+
+	if !(options.typeConfidence > 0 && options.typeConfidence <= 1) {
+		return nil, fmt.Errorf("%g: type confidence not in (0, 1]", options.typeConfidence)
+	}
+
+	if len(g.trueValues) != len(g.falseValues) {
+		return nil, fmt.Errorf("%d true values and %d false values: unpaired bool values", len(g.trueValues), len(g.falseValues))
+	}
+	options.boolValuesTypeNames = boolValuesTypeNames(g.trueValues, g.falseValues)
+
+	switch options.nameConflictStrategy {
+	case NameConflictStrategyError, NameConflictStrategyNamespace, NameConflictStrategyNumber, NameConflictStrategySuffix:
+	default:
+		return nil, fmt.Errorf("%s: unknown name conflict strategy", options.nameConflictStrategy)
+	}
+
 	if options.namedRoot {
 		options.importPackageNames["encoding/xml"] = struct{}{}
 	}
 
+	var roots []*element
+	for _, typeElement := range g.typeElements {
+		if typeElement.root || !g.namedTypes {
+			roots = append(roots, typeElement)
+		}
+	}
+	fieldRenames, typeRenames, err := resolvePathRenames(roots, g.pathRenames)
+	if err != nil {
+		return nil, err
+	}
+	options.fieldRenames = fieldRenames
+	options.typeRenames = typeRenames
+
 	// When compact types is enabled, detect elements that would cause conflicts if compacted
 	nonCompactableElements := make(map[xml.Name]bool)
 	if options.compactTypes {
@@ -300,7 +652,7 @@ func (g *Generator) Generate() ([]byte, error) {
 		}
 		options.simpleTypes = make(map[xml.Name]struct{})
 		for name, element := range options.namedTypes {
-			if len(element.attrValues) != 0 || len(element.childElements) != 0 || element.root {
+			if len(element.attrValues) != 0 || len(element.childElements) != 0 || element.raw || element.hasWildcards() || element.root {
 				continue
 			}
 			options.simpleTypes[name] = struct{}{}
@@ -317,34 +669,72 @@ func (g *Generator) Generate() ([]byte, error) {
 		})
 	} else {
 		slices.SortFunc(typeElements, func(a, b *element) int {
-			aExportedName := options.exportNameFunc(a.name)
-			bExportedName := options.exportNameFunc(b.name)
+			aExportedName, ok := options.typeRenames[a]
+			if !ok {
+				aExportedName = options.exportNameFunc(a.name)
+			}
+			bExportedName, ok := options.typeRenames[b]
+			if !ok {
+				bExportedName = options.exportNameFunc(b.name)
+			}
 			switch {
 			case aExportedName < bExportedName:
 				return -1
 			case aExportedName == bExportedName:
-				return 0
+				return compareNames(a.name, b.name)
 			default:
 				return 1
 			}
 		})
 	}
 
-	typesBuilder := &strings.Builder{}
-	typeNames := make(map[string]struct{})
+	// Resolve all type names before writing any types so that fields refer to
+	// the resolved type names.
+	options.typeNames = make(map[string]struct{})
 	for _, typeElement := range typeElements {
-		typeName := options.exportTypeNameFunc(typeElement.name)
-		if _, ok := typeNames[typeName]; ok {
-			return nil, fmt.Errorf("%s: duplicate type name", typeName)
+		typeName := options.typeName(typeElement)
+		conflict := NameConflict{
+			Element: typeElement.name,
+			OldName: typeName,
 		}
-		typeNames[typeName] = struct{}{}
+		resolvedTypeName, err := options.resolveName(options.typeNames, "type", conflict, typeElement.name.Space, "")
+		if err != nil {
+			return nil, err
+		}
+		if resolvedTypeName != typeName {
+			options.typeRenames[typeElement] = resolvedTypeName
+		}
+	}
+
+	typesBuilder := &strings.Builder{}
+	for _, typeElement := range typeElements {
+		typeName := options.typeName(typeElement)
 		fmt.Fprintf(typesBuilder, "\ntype %s ", typeName)
 		if err := typeElement.writeGoType(typesBuilder, &options, ""); err != nil {
 			return nil, err
 		}
 		typesBuilder.WriteByte('\n')
+		if options.validateMethods && typeElement.hasStructType(&options) {
+			typeElement.writeValidateMethod(typesBuilder, typeName, &options)
+		}
+	}
+	options.addEntityEnumDecls()
+	for _, declName := range slices.Sorted(maps.Keys(options.decls)) {
+		fmt.Fprintf(typesBuilder, "\n%s", options.decls[declName].source)
 	}
 
+	g.nameConflicts = options.nameConflicts
+
+	g.outliers = slices.Concat(slices.Collect(maps.Values(options.outliers))...)
+	slices.SortFunc(g.outliers, func(a, b Outlier) int {
+		return cmp.Or(
+			cmp.Compare(a.Filename, b.Filename),
+			cmp.Compare(a.Line, b.Line),
+			cmp.Compare(a.Column, b.Column),
+			cmp.Compare(a.Value, b.Value),
+		)
+	})
+
 	sourceBuilder := &strings.Builder{}
 	if options.header != "" {
 		fmt.Fprintf(sourceBuilder, "%s\n\n", options.header)
@@ -422,7 +812,7 @@ func (g *Generator) ObserveFS(fsys fs.FS, root string, observeFunc func(string,
 				return err
 			}
 			defer file.Close()
-			if err := g.ObserveReader(file); err != nil {
+			if err := g.observeReader(file, path); err != nil {
 				return fmt.Errorf("%s: %w", path, err)
 			}
 			return nil
@@ -439,17 +829,76 @@ func (g *Generator) ObserveFile(name string) error {
 		return err
 	}
 	defer file.Close()
-	return g.ObserveReader(file)
+	return g.observeReader(file, name)
 }
 
 // ObserveReader observes an XML document from r.
 func (g *Generator) ObserveReader(r io.Reader) error {
+	return g.observeReader(r, "")
+}
+
+// NameConflicts returns the duplicate field and type names that were resolved
+// in the last call to Generate.
+func (g *Generator) NameConflicts() []NameConflict {
+	return g.nameConflicts
+}
+
+// Outliers returns the observed values that did not match the Go types chosen
+// by majority vote in the last call to Generate, sorted by position. Only a
+// bounded sample of outliers is recorded for each attribute and chardata.
+func (g *Generator) Outliers() []Outlier {
+	return g.outliers
+}
+
+// nextOrder returns the next order in which types and fields are observed.
+func (g *Generator) nextOrder() int {
+	g.order++
+	return g.order
+}
+
+// observeReader observes an XML document from r, which was read from filename.
+func (g *Generator) observeReader(r io.Reader, filename string) error {
+	excludePaths, err := parsePathPatterns(g.excludePaths)
+	if err != nil {
+		return err
+	}
+	rawElements, err := parsePathPatterns(g.rawElements)
+	if err != nil {
+		return err
+	}
+	for i, rawElement := range rawElements {
+		if rawElement.selectsAttrs() {
+			return fmt.Errorf("%s: raw element pattern selects attributes", g.rawElements[i])
+		}
+		// With named types, all elements with the same name share a type, so
+		// they are either all raw or none are.
+		if g.namedTypes && !rawElement.matchesName() {
+			return fmt.Errorf("%s: raw element path pattern used with named types", g.rawElements[i])
+		}
+	}
+
+	nameFunc := g.nameFunc
+	if g.htmlMode {
+		nameFunc = func(name xml.Name) xml.Name {
+			return g.nameFunc(lowerCaseName(name))
+		}
+	}
+
 	options := observeOptions{
-		getOrder: func() int {
-			g.order++
-			return g.order
-		},
-		nameFunc:           g.nameFunc,
+		binaryMinLength:    g.binaryMinLength,
+		boolValues:         make(map[string]int),
+		entities:           make(map[string]string),
+		examples:           g.fieldComments,
+		excludePaths:       excludePaths,
+		filename:           filename,
+		getOrder:           g.nextOrder,
+		htmlMode:           g.htmlMode,
+		nameFunc:           nameFunc,
+		namespacePrefixes:  make(namespacePrefixes),
+		rand:               g.rand,
+		rawElements:        rawElements,
+		recordSamples:      g.typeConfidence < 1,
+		stringSubtypes:     g.stringSubtypes,
 		timeLayout:         g.timeLayout,
 		topLevelAttributes: g.topLevelAttributes,
 		typeOrder:          g.typeOrder,
@@ -458,12 +907,22 @@ func (g *Generator) ObserveReader(r io.Reader) error {
 	if g.namedTypes {
 		options.topLevelElements = g.typeElements
 	}
+	for pair := range min(len(g.trueValues), len(g.falseValues)) {
+		options.boolValues[g.trueValues[pair]] = pair
+		options.boolValues[g.falseValues[pair]] = pair
+	}
 
-	decoder := xml.NewDecoder(r)
-	decoder.CharsetReader = charset.NewReaderLabel
+	var decoder *xml.Decoder
+	if g.htmlMode {
+		decoder = newHTMLDecoder(r)
+	} else {
+		decoder = xml.NewDecoder(r)
+		decoder.CharsetReader = charset.NewReaderLabel
+	}
 	if g.modifyDecoderFunc != nil {
 		g.modifyDecoderFunc(decoder)
 	}
+	options.inputPos = decoder.InputPos
 	var foundRootElement bool
 FOR:
 	for {
@@ -480,13 +939,25 @@ FOR:
 		case err != nil:
 			return err
 		default:
+			if directive, ok := token.(xml.Directive); ok {
+				observeDirective(decoder, string(directive), &options)
+				continue FOR
+			}
 			if startElement, ok := token.(xml.StartElement); ok {
 				var root bool
 				if !foundRootElement {
 					foundRootElement = true
 					root = true
 				}
-				name := g.nameFunc(startElement.Name)
+				options.namespacePrefixes.observe(startElement.Attr)
+				path := []pathName{{name: options.pathName(startElement.Name)}}
+				if matchAnyPathPattern(options.excludePaths, path, options.namespacePrefixes) {
+					if err := skipElement(decoder, &options); err != nil {
+						return err
+					}
+					continue FOR
+				}
+				name := nameFunc(startElement.Name)
 				if name == (xml.Name{}) {
 					continue FOR
 				}
@@ -499,7 +970,7 @@ FOR:
 				if _, ok := g.typeOrder[name]; !ok {
 					g.typeOrder[name] = options.getOrder()
 				}
-				if err := typeElement.observeChildElement(decoder, startElement, 0, &options); err != nil {
+				if err := typeElement.observeChildElement(decoder, startElement, path, &options); err != nil {
 					return err
 				}
 			}
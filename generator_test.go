@@ -1,6 +1,7 @@
 package xmlstruct_test
 
 import (
+	"encoding/xml"
 	"strings"
 	"testing"
 
@@ -900,6 +901,1765 @@ func TestGenerator(t *testing.T) {
 				"}",
 			),
 		},
+		{
+			name: "nillable",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStrs: []string{
+				joinLines(
+					`<a xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">`,
+					`  <b>1</b>`,
+					`  <c xsi:nil="true"/>`,
+					`</a>`,
+				),
+				joinLines(
+					`<a xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">`,
+					`  <b xsi:nil="true"/>`,
+					`  <c xsi:nil="false">d</c>`,
+					`</a>`,
+				),
+			},
+			expectedStr: joinLines(
+				`import "encoding/xml"`,
+				"",
+				"type A struct {",
+				"\tB Nillable[int]    `xml:\"b\"`",
+				"\tC Nillable[string] `xml:\"c\"`",
+				"}",
+				"",
+				`// A Nillable is an element that may be nil, indicated by xsi:nil="true".`,
+				"type Nillable[T any] struct {",
+				"\tValue *T",
+				"}",
+				"",
+				"// MarshalXML implements encoding/xml.Marshaler.",
+				"func (n Nillable[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {",
+				"\tif n.Value == nil {",
+				"\t\tstart.Attr = append(start.Attr, xml.Attr{",
+				"\t\t\tName:  xml.Name{Space: \"http://www.w3.org/2001/XMLSchema-instance\", Local: \"nil\"},",
+				"\t\t\tValue: \"true\",",
+				"\t\t})",
+				"\t\tif err := e.EncodeToken(start); err != nil {",
+				"\t\t\treturn err",
+				"\t\t}",
+				"\t\treturn e.EncodeToken(start.End())",
+				"\t}",
+				"\treturn e.EncodeElement(n.Value, start)",
+				"}",
+				"",
+				"// UnmarshalXML implements encoding/xml.Unmarshaler.",
+				"func (n *Nillable[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {",
+				"\tfor _, attr := range start.Attr {",
+				"\t\tif attr.Name.Local == \"nil\" && (attr.Name.Space == \"http://www.w3.org/2001/XMLSchema-instance\" || attr.Name.Space == \"xsi\") && (attr.Value == \"true\" || attr.Value == \"1\") {",
+				"\t\t\tn.Value = nil",
+				"\t\t\treturn d.Skip()",
+				"\t\t}",
+				"\t}",
+				"\tn.Value = new(T)",
+				"\treturn d.DecodeElement(n.Value, &start)",
+				"}",
+			),
+		},
+		{
+			name: "lang_maps",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithLangMaps(true),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b xml:lang="en">dog</b>`,
+				`  <b xml:lang="de">Hund</b>`,
+				`  <c xml:lang="en">cat</c>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"import (",
+				"\t\"encoding/xml\"",
+				"\t\"fmt\"",
+				"\t\"maps\"",
+				"\t\"slices\"",
+				")",
+				"",
+				"type A struct {",
+				"\tB LangMap `xml:\"b\"`",
+				"\tC struct {",
+				"\t\tLang     string `xml:\"lang,attr\"`",
+				"\t\tCharData string `xml:\",chardata\"`",
+				"\t} `xml:\"c\"`",
+				"}",
+				"",
+				"// A LangMap maps xml:lang values to chardata.",
+				"type LangMap map[string]string",
+				"",
+				"// MarshalXML implements encoding/xml.Marshaler.",
+				"func (m LangMap) MarshalXML(e *xml.Encoder, start xml.StartElement) error {",
+				"\tfor _, lang := range slices.Sorted(maps.Keys(m)) {",
+				"\t\tlangStart := start",
+				"\t\tif lang != \"\" {",
+				"\t\t\tlangStart.Attr = append(slices.Clip(start.Attr), xml.Attr{",
+				"\t\t\t\tName:  xml.Name{Space: \"http://www.w3.org/XML/1998/namespace\", Local: \"lang\"},",
+				"\t\t\t\tValue: lang,",
+				"\t\t\t})",
+				"\t\t}",
+				"\t\tif err := e.EncodeElement(m[lang], langStart); err != nil {",
+				"\t\t\treturn err",
+				"\t\t}",
+				"\t}",
+				"\treturn nil",
+				"}",
+				"",
+				"// UnmarshalXML implements encoding/xml.Unmarshaler.",
+				"func (m *LangMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {",
+				"\tvar lang string",
+				"\tfor _, attr := range start.Attr {",
+				"\t\tif attr.Name.Local == \"lang\" && (attr.Name.Space == \"http://www.w3.org/XML/1998/namespace\" || attr.Name.Space == \"xml\") {",
+				"\t\t\tlang = attr.Value",
+				"\t\t}",
+				"\t}",
+				"\tvar charData string",
+				"\tif err := d.DecodeElement(&charData, &start); err != nil {",
+				"\t\treturn err",
+				"\t}",
+				"\tif *m == nil {",
+				"\t\t*m = make(LangMap)",
+				"\t}",
+				"\tif _, ok := (*m)[lang]; ok {",
+				"\t\treturn fmt.Errorf(\"%q: duplicate xml:lang\", lang)",
+				"\t}",
+				"\t(*m)[lang] = charData",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "lang_maps_duplicate_lang",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithLangMaps(true),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <sense>`,
+				`    <gloss xml:lang="eng">dog</gloss>`,
+				`    <gloss xml:lang="ger">Hund</gloss>`,
+				`  </sense>`,
+				`  <sense>`,
+				`    <gloss xml:lang="eng">dog</gloss>`,
+				`    <gloss xml:lang="eng">hound</gloss>`,
+				`  </sense>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tSense []struct {",
+				"\t\tGloss []struct {",
+				"\t\t\tLang     string `xml:\"lang,attr\"`",
+				"\t\t\tCharData string `xml:\",chardata\"`",
+				"\t\t} `xml:\"gloss\"`",
+				"\t} `xml:\"sense\"`",
+				"}",
+			),
+		},
+		{
+			name: "lang_maps_unqualified_lang",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithLangMaps(true),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b lang="en">dog</b>`,
+				`  <b lang="de">Hund</b>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB []struct {",
+				"\t\tLang     string `xml:\"lang,attr\"`",
+				"\t\tCharData string `xml:\",chardata\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "sized_int_types",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithSizedIntTypes(true),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b c="1" d="-200" e="70000" f="18446744073709551615" g="-1">99999999999999999999999</b>`,
+				`  <b c="255" d="0" e="0" f="0" g="18446744073709551615">0</b>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				`import "math/big"`,
+				"",
+				"type A struct {",
+				"\tB []struct {",
+				"\t\tC        uint8    `xml:\"c,attr\"`",
+				"\t\tD        int16    `xml:\"d,attr\"`",
+				"\t\tE        uint32   `xml:\"e,attr\"`",
+				"\t\tF        uint64   `xml:\"f,attr\"`",
+				"\t\tG        *big.Int `xml:\"g,attr\"`",
+				"\t\tCharData string   `xml:\",chardata\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "big_int_type_string",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithBigIntType("string"),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithSizedIntTypes(true),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>99999999999999999999999</b>`,
+				`  <c>-129</c>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB string `xml:\"b\"`",
+				"\tC int16  `xml:\"c\"`",
+				"}",
+			),
+		},
+		{
+			name: "big_int_type_qualified",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithBigIntType("*github.com/example/bigint.Int"),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithSizedIntTypes(true),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>99999999999999999999999</b>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				`import "github.com/example/bigint"`,
+				"",
+				"type A struct {",
+				"\tB *bigint.Int `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "big_int_type_invalid",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithBigIntType("big int"),
+			},
+			xmlStr:      `<a>1</a>`,
+			expectedErr: "big int: invalid big int type",
+		},
+		{
+			name: "big_int_type_unqualified",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithBigIntType("BigInt"),
+			},
+			xmlStr:      `<a>1</a>`,
+			expectedErr: "BigInt: invalid big int type",
+		},
+		{
+			name: "preserve_leading_zeros",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>007</b>`,
+				`  <c>7</c>`,
+				`  <d>+1</d>`,
+				`  <e>-0</e>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB string `xml:\"b\"`",
+				"\tC int    `xml:\"c\"`",
+				"\tD string `xml:\"d\"`",
+				"\tE string `xml:\"e\"`",
+				"}",
+			),
+		},
+		{
+			name: "without_preserve_leading_zeros",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithPreserveLeadingZeros(false),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>007</b>`,
+				`  <c>7</c>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB int `xml:\"b\"`",
+				"\tC int `xml:\"c\"`",
+				"}",
+			),
+		},
+		{
+			name: "decimal_type",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithDecimalType("Decimal"),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>12.3400</b>`,
+				`  <c>1e5</c>`,
+				`  <d>1</d>`,
+				`  <d>2.5</d>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"import (",
+				"\t\"fmt\"",
+				"\t\"strconv\"",
+				")",
+				"",
+				"type A struct {",
+				"\tB Decimal   `xml:\"b\"`",
+				"\tC float64   `xml:\"c\"`",
+				"\tD []Decimal `xml:\"d\"`",
+				"}",
+				"",
+				"// A Decimal is a decimal number that preserves its exact textual",
+				"// representation.",
+				"type Decimal string",
+				"",
+				"// Float64 returns d as a float64.",
+				"func (d Decimal) Float64() (float64, error) {",
+				"\treturn strconv.ParseFloat(string(d), 64)",
+				"}",
+				"",
+				"// MarshalText implements encoding.TextMarshaler.",
+				"func (d Decimal) MarshalText() ([]byte, error) {",
+				"\treturn []byte(d), nil",
+				"}",
+				"",
+				"// UnmarshalText implements encoding.TextUnmarshaler.",
+				"func (d *Decimal) UnmarshalText(text []byte) error {",
+				"\tdigits, point := 0, false",
+				"\tfor i, c := range text {",
+				"\t\tswitch {",
+				"\t\tcase '0' <= c && c <= '9':",
+				"\t\t\tdigits++",
+				"\t\tcase c == '.' && !point:",
+				"\t\t\tpoint = true",
+				"\t\tcase (c == '+' || c == '-') && i == 0:",
+				"\t\tdefault:",
+				"\t\t\treturn fmt.Errorf(\"%q: invalid Decimal\", text)",
+				"\t\t}",
+				"\t}",
+				"\tif digits == 0 {",
+				"\t\treturn fmt.Errorf(\"%q: invalid Decimal\", text)",
+				"\t}",
+				"\t*d = Decimal(text)",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "qualified_decimal_type",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithDecimalType("github.com/shopspring/decimal.Decimal"),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>12.3400</b>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				`import "github.com/shopspring/decimal"`,
+				"",
+				"type A struct {",
+				"\t// precision 6, scale 4",
+				"\tB decimal.Decimal `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "qualified_decimal_type_attr",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithDecimalType("github.com/shopspring/decimal.Decimal"),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b c="1.5"/>`,
+				`  <b c="-100.25"/>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				`import "github.com/shopspring/decimal"`,
+				"",
+				"type A struct {",
+				"\tB []struct {",
+				"\t\t// precision 5, scale 2",
+				"\t\tC decimal.Decimal `xml:\"c,attr\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "bool_values",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithBoolValues([]string{"yes", "Y", "1"}, []string{"no", "N", "0"}),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b c="yes" d="yes" e="1"/>`,
+				`  <b c="no" d="N" e="2"/>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				`import "fmt"`,
+				"",
+				"type A struct {",
+				"\tB []struct {",
+				"\t\tC BoolYesNo `xml:\"c,attr\"`",
+				"\t\tD string    `xml:\"d,attr\"`",
+				"\t\tE int       `xml:\"e,attr\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+				"",
+				`// A BoolYesNo is a bool represented as "yes" or "no".`,
+				"type BoolYesNo bool",
+				"",
+				"// MarshalText implements encoding.TextMarshaler.",
+				"func (b BoolYesNo) MarshalText() ([]byte, error) {",
+				"\tif b {",
+				"\t\treturn []byte(\"yes\"), nil",
+				"\t}",
+				"\treturn []byte(\"no\"), nil",
+				"}",
+				"",
+				"// UnmarshalText implements encoding.TextUnmarshaler.",
+				"func (b *BoolYesNo) UnmarshalText(text []byte) error {",
+				"\tswitch string(text) {",
+				"\tcase \"yes\":",
+				"\t\t*b = true",
+				"\tcase \"no\":",
+				"\t\t*b = false",
+				"\tdefault:",
+				"\t\treturn fmt.Errorf(\"%q: invalid BoolYesNo\", text)",
+				"\t}",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "bool_values_case",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithBoolValues([]string{"Y", "y"}, []string{"N", "n"}),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b c="Y" d="n"/>`,
+				`  <b c="N" d="y"/>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				`import "fmt"`,
+				"",
+				"type A struct {",
+				"\tB []struct {",
+				"\t\tC BoolYN  `xml:\"c,attr\"`",
+				"\t\tD BoolYN2 `xml:\"d,attr\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+				"",
+				`// A BoolYN is a bool represented as "Y" or "N".`,
+				"type BoolYN bool",
+				"",
+				"// MarshalText implements encoding.TextMarshaler.",
+				"func (b BoolYN) MarshalText() ([]byte, error) {",
+				"\tif b {",
+				"\t\treturn []byte(\"Y\"), nil",
+				"\t}",
+				"\treturn []byte(\"N\"), nil",
+				"}",
+				"",
+				"// UnmarshalText implements encoding.TextUnmarshaler.",
+				"func (b *BoolYN) UnmarshalText(text []byte) error {",
+				"\tswitch string(text) {",
+				"\tcase \"Y\":",
+				"\t\t*b = true",
+				"\tcase \"N\":",
+				"\t\t*b = false",
+				"\tdefault:",
+				"\t\treturn fmt.Errorf(\"%q: invalid BoolYN\", text)",
+				"\t}",
+				"\treturn nil",
+				"}",
+				"",
+				`// A BoolYN2 is a bool represented as "y" or "n".`,
+				"type BoolYN2 bool",
+				"",
+				"// MarshalText implements encoding.TextMarshaler.",
+				"func (b BoolYN2) MarshalText() ([]byte, error) {",
+				"\tif b {",
+				"\t\treturn []byte(\"y\"), nil",
+				"\t}",
+				"\treturn []byte(\"n\"), nil",
+				"}",
+				"",
+				"// UnmarshalText implements encoding.TextUnmarshaler.",
+				"func (b *BoolYN2) UnmarshalText(text []byte) error {",
+				"\tswitch string(text) {",
+				"\tcase \"y\":",
+				"\t\t*b = true",
+				"\tcase \"n\":",
+				"\t\t*b = false",
+				"\tdefault:",
+				"\t\treturn fmt.Errorf(\"%q: invalid BoolYN2\", text)",
+				"\t}",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "bool_values_unpaired",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithBoolValues([]string{"yes", "Y"}, []string{"no"}),
+			},
+			xmlStr:      `<a b="yes"/>`,
+			expectedErr: "2 true values and 1 false values: unpaired bool values",
+		},
+		{
+			name: "binary_min_length",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithBinaryMinLength(16),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>iVBORw0KGgoAAAANSUhEUgAAAAEAAAAB</b>`,
+				`  <c>deadbeefdeadbeefdeadbeef</c>`,
+				`  <d>abcd</d>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"import (",
+				"\t\"bytes\"",
+				"\t\"encoding/base64\"",
+				"\t\"encoding/hex\"",
+				")",
+				"",
+				"type A struct {",
+				"\tB Base64Binary `xml:\"b\"`",
+				"\tC HexBinary    `xml:\"c\"`",
+				"\tD string       `xml:\"d\"`",
+				"}",
+				"",
+				"// A Base64Binary is binary data encoded as base64.",
+				"type Base64Binary []byte",
+				"",
+				"// MarshalText implements encoding.TextMarshaler.",
+				"func (b Base64Binary) MarshalText() ([]byte, error) {",
+				"\ttext := make([]byte, base64.StdEncoding.EncodedLen(len(b)))",
+				"\tbase64.StdEncoding.Encode(text, b)",
+				"\treturn text, nil",
+				"}",
+				"",
+				"// UnmarshalText implements encoding.TextUnmarshaler.",
+				"func (b *Base64Binary) UnmarshalText(text []byte) error {",
+				"\ttext = bytes.Join(bytes.Fields(text), nil)",
+				"\tdata := make([]byte, base64.StdEncoding.DecodedLen(len(text)))",
+				"\tn, err := base64.StdEncoding.Decode(data, text)",
+				"\tif err != nil {",
+				"\t\treturn err",
+				"\t}",
+				"\t*b = data[:n]",
+				"\treturn nil",
+				"}",
+				"",
+				"// A HexBinary is binary data encoded as hexadecimal.",
+				"type HexBinary []byte",
+				"",
+				"// MarshalText implements encoding.TextMarshaler.",
+				"func (b HexBinary) MarshalText() ([]byte, error) {",
+				"\ttext := make([]byte, hex.EncodedLen(len(b)))",
+				"\thex.Encode(text, b)",
+				"\treturn text, nil",
+				"}",
+				"",
+				"// UnmarshalText implements encoding.TextUnmarshaler.",
+				"func (b *HexBinary) UnmarshalText(text []byte) error {",
+				"\ttext = bytes.Join(bytes.Fields(text), nil)",
+				"\tdata := make([]byte, hex.DecodedLen(len(text)))",
+				"\tif _, err := hex.Decode(data, text); err != nil {",
+				"\t\treturn err",
+				"\t}",
+				"\t*b = data",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "string_subtypes",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithStringSubtypes(true),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b href="https://example.com/b" id="123e4567-e89b-12d3-a456-426614174000" email="b@example.com"/>`,
+				`  <b href="/c" id="223e4567-e89b-12d3-a456-426614174000" email="c@example.com"/>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"import (",
+				"\t\"fmt\"",
+				"\t\"net/mail\"",
+				")",
+				"",
+				"type A struct {",
+				"\tB []struct {",
+				"\t\tEmail Email  `xml:\"email,attr\"`",
+				"\t\tHref  string `xml:\"href,attr\"`",
+				"\t\tID    UUID   `xml:\"id,attr\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+				"",
+				"// An Email is an email address.",
+				"type Email string",
+				"",
+				"// Validate returns an error if e is not a valid email address.",
+				"func (e Email) Validate() error {",
+				"\taddress, err := mail.ParseAddress(string(e))",
+				"\tif err != nil {",
+				"\t\treturn err",
+				"\t}",
+				"\tif address.Name != \"\" || address.Address != string(e) {",
+				"\t\treturn fmt.Errorf(\"%q: invalid Email\", string(e))",
+				"\t}",
+				"\treturn nil",
+				"}",
+				"",
+				"// A UUID is a UUID in its canonical textual representation.",
+				"type UUID string",
+				"",
+				"// Validate returns an error if u is not a valid UUID.",
+				"func (u UUID) Validate() error {",
+				"\tif len(u) != 36 {",
+				"\t\treturn fmt.Errorf(\"%q: invalid UUID\", string(u))",
+				"\t}",
+				"\tfor i, c := range []byte(u) {",
+				"\t\tswitch i {",
+				"\t\tcase 8, 13, 18, 23:",
+				"\t\t\tif c != '-' {",
+				"\t\t\t\treturn fmt.Errorf(\"%q: invalid UUID\", string(u))",
+				"\t\t\t}",
+				"\t\tdefault:",
+				"\t\t\tif !('0' <= c && c <= '9' || 'A' <= c && c <= 'F' || 'a' <= c && c <= 'f') {",
+				"\t\t\t\treturn fmt.Errorf(\"%q: invalid UUID\", string(u))",
+				"\t\t\t}",
+				"\t\t}",
+				"\t}",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "string_subtypes_url",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithStringSubtypes(true),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <link>https://example.com/b</link>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				`import "net/url"`,
+				"",
+				"type A struct {",
+				"\tLink URL `xml:\"link\"`",
+				"}",
+				"",
+				"// A URL wraps an absolute *url.URL.",
+				"type URL struct {",
+				"\t*url.URL",
+				"}",
+				"",
+				"// MarshalText implements encoding.TextMarshaler.",
+				"func (u URL) MarshalText() ([]byte, error) {",
+				"\tif u.URL == nil {",
+				"\t\treturn nil, nil",
+				"\t}",
+				"\treturn []byte(u.URL.String()), nil",
+				"}",
+				"",
+				"// UnmarshalText implements encoding.TextUnmarshaler.",
+				"func (u *URL) UnmarshalText(text []byte) error {",
+				"\tparsedURL, err := url.Parse(string(text))",
+				"\tif err != nil {",
+				"\t\treturn err",
+				"\t}",
+				"\tu.URL = parsedURL",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "string_subtypes_url_named_type",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithNamedTypes(true),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithStringSubtypes(true),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <URL href="https://example.com/b"/>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				`import "net/url"`,
+				"",
+				"type A struct {",
+				"\tURL URL `xml:\"URL\"`",
+				"}",
+				"",
+				"type URL struct {",
+				"\tHref URL2 `xml:\"href,attr\"`",
+				"}",
+				"",
+				"// A URL2 wraps an absolute *url.URL.",
+				"type URL2 struct {",
+				"\t*url.URL",
+				"}",
+				"",
+				"// MarshalText implements encoding.TextMarshaler.",
+				"func (u URL2) MarshalText() ([]byte, error) {",
+				"\tif u.URL == nil {",
+				"\t\treturn nil, nil",
+				"\t}",
+				"\treturn []byte(u.URL.String()), nil",
+				"}",
+				"",
+				"// UnmarshalText implements encoding.TextUnmarshaler.",
+				"func (u *URL2) UnmarshalText(text []byte) error {",
+				"\tparsedURL, err := url.Parse(string(text))",
+				"\tif err != nil {",
+				"\t\treturn err",
+				"\t}",
+				"\tu.URL = parsedURL",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "type_confidence",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithTypeConfidence(0.75),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>1</b>`,
+				`  <b>2</b>`,
+				`  <b>3</b>`,
+				`  <b>n/a</b>`,
+				`  <c>true</c>`,
+				`  <c>1.5</c>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB []int    `xml:\"b\"`",
+				"\tC []string `xml:\"c\"`",
+				"}",
+			),
+		},
+		{
+			name: "type_confidence_zero",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithTypeConfidence(0),
+			},
+			xmlStr:      `<a b="1"/>`,
+			expectedErr: "0: type confidence not in (0, 1]",
+		},
+		{
+			name: "type_confidence_greater_than_one",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithTypeConfidence(1.5),
+			},
+			xmlStr:      `<a b="1"/>`,
+			expectedErr: "1.5: type confidence not in (0, 1]",
+		},
+		{
+			name: "field_comments",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithFieldComments(true),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>N52.123</b>`,
+				`  <b>N48.001</b>`,
+				`  <b>N48.001</b>`,
+				`  <b/>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\t// e.g. \"N52.123\", \"N48.001\"; seen 3/4 times",
+				"\tB []string `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "validate_methods",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithValidateMethods(true),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b c="x" d="1.5">`,
+				`    <e>foo</e>`,
+				`  </b>`,
+				`  <b c="y" d="2">`,
+				`    <e>barbaz</e>`,
+				`    <f>3</f>`,
+				`  </b>`,
+				`  <b c="x" d="4"/>`,
+				`  <b c="y" d="-1"/>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				`import (`,
+				`	"errors"`,
+				`	"fmt"`,
+				`	"unicode/utf8"`,
+				`)`,
+				``,
+				"type A struct {",
+				"\tB []struct {",
+				"\t\tC string  `xml:\"c,attr\"`",
+				"\t\tD float64 `xml:\"d,attr\"`",
+				"\t\tE *string `xml:\"e\"`",
+				"\t\tF *int    `xml:\"f\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+				"",
+				"// Validate returns an error if v does not satisfy the constraints observed in",
+				"// the XML documents.",
+				"func (v *A) Validate() error {",
+				"\tif len(v.B) == 0 {",
+				`		return errors.New("a/b: missing")`,
+				"\t}",
+				"\tfor _, v1 := range v.B {",
+				"\t\tswitch v1.C {",
+				`		case "x", "y":`,
+				"\t\tdefault:",
+				`			return fmt.Errorf("a/b/@c: %q: invalid value", v1.C)`,
+				"\t\t}",
+				"\t\tif v1.D < -1 || v1.D > 4 {",
+				`			return fmt.Errorf("a/b/@d: %g: out of range [-1, 4]", v1.D)`,
+				"\t\t}",
+				"\t\tif v1.E != nil {",
+				"\t\t\tif length := utf8.RuneCountInString(*v1.E); length < 3 || length > 6 {",
+				`				return fmt.Errorf("a/b/e: %q: length not in [3, 6]", *v1.E)`,
+				"\t\t\t}",
+				"\t\t}",
+				"\t\tif v1.F != nil {",
+				"\t\t\tif *v1.F < 3 || *v1.F > 3 {",
+				`				return fmt.Errorf("a/b/f: %d: out of range [3, 3]", *v1.F)`,
+				"\t\t\t}",
+				"\t\t}",
+				"\t}",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "validate_methods_no_fmt",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithValidateMethods(true),
+			},
+			xmlStr: `<a><b>true</b></a>`,
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB bool `xml:\"b\"`",
+				"}",
+				"",
+				"// Validate returns an error if v does not satisfy the constraints observed in",
+				"// the XML documents.",
+				"func (v *A) Validate() error {",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "validate_methods_only_missing",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithValidateMethods(true),
+			},
+			xmlStr: `<a><b/><b/></a>`,
+			expectedStr: joinLines(
+				`import "errors"`,
+				"",
+				"type A struct {",
+				"\tB []struct{} `xml:\"b\"`",
+				"}",
+				"",
+				"// Validate returns an error if v does not satisfy the constraints observed in",
+				"// the XML documents.",
+				"func (v *A) Validate() error {",
+				"\tif len(v.B) == 0 {",
+				"\t\treturn errors.New(\"a/b: missing\")",
+				"\t}",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "validate_methods_optional",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithUsePointersForOptionalFields(false),
+				xmlstruct.WithValidateMethods(true),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>x</b>`,
+				`  <b c="yes"><d>zz</d><e f="1"/></b>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"import (",
+				"\t\"errors\"",
+				"\t\"fmt\"",
+				"\t\"unicode/utf8\"",
+				")",
+				"",
+				"type A struct {",
+				"\tB []struct {",
+				"\t\tC        string `xml:\"c,attr\"`",
+				"\t\tCharData string `xml:\",chardata\"`",
+				"\t\tD        string `xml:\"d\"`",
+				"\t\tE        struct {",
+				"\t\t\tF int `xml:\"f,attr\"`",
+				"\t\t} `xml:\"e\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+				"",
+				"// Validate returns an error if v does not satisfy the constraints observed in",
+				"// the XML documents.",
+				"func (v *A) Validate() error {",
+				"\tif len(v.B) == 0 {",
+				"\t\treturn errors.New(\"a/b: missing\")",
+				"\t}",
+				"\tfor _, v1 := range v.B {",
+				"\t\tif length := utf8.RuneCountInString(v1.C); length < 0 || length > 3 {",
+				"\t\t\treturn fmt.Errorf(\"a/b/@c: %q: length not in [0, 3]\", v1.C)",
+				"\t\t}",
+				"\t\tif length := utf8.RuneCountInString(v1.CharData); length < 0 || length > 1 {",
+				"\t\t\treturn fmt.Errorf(\"a/b: %q: length not in [0, 1]\", v1.CharData)",
+				"\t\t}",
+				"\t\tif length := utf8.RuneCountInString(v1.D); length < 0 || length > 2 {",
+				"\t\t\treturn fmt.Errorf(\"a/b/d: %q: length not in [0, 2]\", v1.D)",
+				"\t\t}",
+				"\t}",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "validate_methods_receiver",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithValidateMethods(true),
+			},
+			xmlStrs: []string{
+				`<note><title>Shopping</title></note>`,
+				`<note><title>Appointments</title></note>`,
+			},
+			expectedStr: joinLines(
+				`import (`,
+				`	"fmt"`,
+				`	"unicode/utf8"`,
+				`)`,
+				``,
+				"type Note struct {",
+				"\tTitle string `xml:\"title\"`",
+				"}",
+				"",
+				"// Validate returns an error if v does not satisfy the constraints observed in",
+				"// the XML documents.",
+				"func (v *Note) Validate() error {",
+				"\tif length := utf8.RuneCountInString(v.Title); length < 8 || length > 12 {",
+				`		return fmt.Errorf("note/title: %q: length not in [8, 12]", v.Title)`,
+				"\t}",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "validate_methods_string_subtypes",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithStringSubtypes(true),
+				xmlstruct.WithUsePointersForOptionalFields(false),
+				xmlstruct.WithValidateMethods(true),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b email="b@example.com" id="123e4567-e89b-12d3-a456-426614174000"/>`,
+				`  <b email="c@example.com"/>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"import (",
+				"\t\"errors\"",
+				"\t\"fmt\"",
+				"\t\"net/mail\"",
+				")",
+				"",
+				"type A struct {",
+				"\tB []struct {",
+				"\t\tEmail Email `xml:\"email,attr\"`",
+				"\t\tID    UUID  `xml:\"id,attr\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+				"",
+				"// Validate returns an error if v does not satisfy the constraints observed in",
+				"// the XML documents.",
+				"func (v *A) Validate() error {",
+				"\tif len(v.B) == 0 {",
+				"\t\treturn errors.New(\"a/b: missing\")",
+				"\t}",
+				"\tfor _, v1 := range v.B {",
+				"\t\tif err := v1.Email.Validate(); err != nil {",
+				"\t\t\treturn fmt.Errorf(\"a/b/@email: %w\", err)",
+				"\t\t}",
+				"\t\tif v1.ID != \"\" {",
+				"\t\t\tif err := v1.ID.Validate(); err != nil {",
+				"\t\t\t\treturn fmt.Errorf(\"a/b/@id: %w\", err)",
+				"\t\t\t}",
+				"\t\t}",
+				"\t}",
+				"\treturn nil",
+				"}",
+				"",
+				"// An Email is an email address.",
+				"type Email string",
+				"",
+				"// Validate returns an error if e is not a valid email address.",
+				"func (e Email) Validate() error {",
+				"\taddress, err := mail.ParseAddress(string(e))",
+				"\tif err != nil {",
+				"\t\treturn err",
+				"\t}",
+				"\tif address.Name != \"\" || address.Address != string(e) {",
+				"\t\treturn fmt.Errorf(\"%q: invalid Email\", string(e))",
+				"\t}",
+				"\treturn nil",
+				"}",
+				"",
+				"// A UUID is a UUID in its canonical textual representation.",
+				"type UUID string",
+				"",
+				"// Validate returns an error if u is not a valid UUID.",
+				"func (u UUID) Validate() error {",
+				"\tif len(u) != 36 {",
+				"\t\treturn fmt.Errorf(\"%q: invalid UUID\", string(u))",
+				"\t}",
+				"\tfor i, c := range []byte(u) {",
+				"\t\tswitch i {",
+				"\t\tcase 8, 13, 18, 23:",
+				"\t\t\tif c != '-' {",
+				"\t\t\t\treturn fmt.Errorf(\"%q: invalid UUID\", string(u))",
+				"\t\t\t}",
+				"\t\tdefault:",
+				"\t\t\tif !('0' <= c && c <= '9' || 'A' <= c && c <= 'F' || 'a' <= c && c <= 'f') {",
+				"\t\t\t\treturn fmt.Errorf(\"%q: invalid UUID\", string(u))",
+				"\t\t\t}",
+				"\t\t}",
+				"\t}",
+				"\treturn nil",
+				"}",
+			),
+		},
+		{
+			name: "tags_camel",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithTags([]string{"json", "yaml"}),
+				xmlstruct.WithTagNameCase(xmlstruct.TagNameCaseCamel),
+			},
+			xmlStrs: []string{
+				`<a><b-c d_e="1">f</b-c><GPSTime>1</GPSTime></a>`,
+				`<a><b-c>f</b-c><GPSTime>2</GPSTime></a>`,
+			},
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tBC struct {",
+				"\t\tDE       *int   `xml:\"d_e,attr\" json:\"dE,omitempty\" yaml:\"dE,omitempty\"`",
+				"\t\tCharData string `xml:\",chardata\" json:\"charData\" yaml:\"charData\"`",
+				"\t} `xml:\"b-c\" json:\"bC\" yaml:\"bC\"`",
+				"\tGPSTime int `xml:\"GPSTime\" json:\"gpsTime\" yaml:\"gpsTime\"`",
+				"}",
+			),
+		},
+		{
+			name: "tags_snake",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithTags([]string{"json"}),
+				xmlstruct.WithTagNameCase(xmlstruct.TagNameCaseSnake),
+			},
+			xmlStr: `<a><fooBar>1</fooBar><GPSTime>1</GPSTime></a>`,
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tFooBar  int `xml:\"fooBar\" json:\"foo_bar\"`",
+				"\tGPSTime int `xml:\"GPSTime\" json:\"gps_time\"`",
+				"}",
+			),
+		},
+		{
+			name: "tags_unknown_tag_name_case",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithTagNameCase("kebab"),
+			},
+			xmlStr:      `<a/>`,
+			expectedErr: "kebab: unknown tag name case",
+		},
+		{
+			name: "omit_empty",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithOmitEmpty(true),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b c="1" d="2"><e/><f/></b>`,
+				`  <b c="3"><f/></b>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB []struct {",
+				"\t\tC int       `xml:\"c,attr\"`",
+				"\t\tD *int      `xml:\"d,attr,omitempty\"`",
+				"\t\tE *struct{} `xml:\"e,omitempty\"`",
+				"\t\tF struct{}  `xml:\"f\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "omit_empty_without_pointers",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithOmitEmpty(true),
+				xmlstruct.WithUsePointersForOptionalFields(false),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b c="1" d="2"><e/><f/><g><h/></g><i>x</i></b>`,
+				`  <b c="3"><f/></b>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB []struct {",
+				"\t\tC int      `xml:\"c,attr\"`",
+				"\t\tD int      `xml:\"d,attr,omitempty\"`",
+				"\t\tE struct{} `xml:\"e\"`",
+				"\t\tF struct{} `xml:\"f\"`",
+				"\t\tG struct {",
+				"\t\t\tH struct{} `xml:\"h\"`",
+				"\t\t} `xml:\"g\"`",
+				"\t\tI string `xml:\"i,omitempty\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "catch_all",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithCatchAll(true),
+			},
+			xmlStr: `<a><b c="1"/></a>`,
+			expectedStr: joinLines(
+				`import "encoding/xml"`,
+				"",
+				"type A struct {",
+				"\tExtra []xml.Attr `xml:\",any,attr\"`",
+				"\tB     struct {",
+				"\t\tC             int          `xml:\"c,attr\"`",
+				"\t\tExtra         []xml.Attr   `xml:\",any,attr\"`",
+				"\t\tExtraElements []AnyElement `xml:\",any\"`",
+				"\t} `xml:\"b\"`",
+				"\tExtraElements []AnyElement `xml:\",any\"`",
+				"}",
+				"",
+				"// An AnyElement is an element that was not observed when generating this",
+				"// source, with its attributes and inner XML preserved.",
+				"type AnyElement struct {",
+				"\tXMLName  xml.Name",
+				"\tAttrs    []xml.Attr `xml:\",any,attr\"`",
+				"\tInnerXML string     `xml:\",innerxml\"`",
+				"}",
+			),
+		},
+		{
+			name: "catch_all_duplicate_field_name",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithCatchAll(true),
+			},
+			xmlStr:      `<a><extra/></a>`,
+			expectedErr: "Extra: duplicate field name",
+		},
+		{
+			name: "raw_elements",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithRawElements([]string{"/a/*/c", "e"}),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>`,
+				`    <c d="1"><x/><y/></c>`,
+				`    <e><z/></e>`,
+				`  </b>`,
+				`  <c><w/></c>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB struct {",
+				"\t\tC struct {",
+				"\t\t\tD        int    `xml:\"d,attr\"`",
+				"\t\t\tInnerXML string `xml:\",innerxml\"`",
+				"\t\t} `xml:\"c\"`",
+				"\t\tE struct {",
+				"\t\t\tInnerXML string `xml:\",innerxml\"`",
+				"\t\t} `xml:\"e\"`",
+				"\t} `xml:\"b\"`",
+				"\tC struct {",
+				"\t\tW struct{} `xml:\"w\"`",
+				"\t} `xml:\"c\"`",
+				"}",
+			),
+		},
+		{
+			name: "exclude_paths",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithExcludePaths([]string{"//@xmlns:*", "/a/b/*/e", "@f"}),
+				xmlstruct.WithTopLevelAttributes(true),
+			},
+			xmlStr: joinLines(
+				`<a xmlns:x="urn:x" g="1">`,
+				`  <b f="2">`,
+				`    <c><e>3</e><h>4</h></c>`,
+				`    <d><e>5</e></d>`,
+				`    <e>6</e>`,
+				`  </b>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tG int `xml:\"g,attr\"`",
+				"\tB struct {",
+				"\t\tC struct {",
+				"\t\t\tH int `xml:\"h\"`",
+				"\t\t} `xml:\"c\"`",
+				"\t\tD struct{} `xml:\"d\"`",
+				"\t\tE int      `xml:\"e\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "path_renames",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithPathRenames(map[string]string{
+					"/rss/channel/item/title": "Headline",
+					"/rss/channel/item/@id":   "GUID",
+					"//image":                 "Picture",
+				}),
+			},
+			xmlStr: joinLines(
+				`<rss>`,
+				`  <channel>`,
+				`    <title>a</title>`,
+				`    <image><title>b</title></image>`,
+				`    <item id="c"><title>d</title></item>`,
+				`  </channel>`,
+				`</rss>`,
+			),
+			expectedStr: joinLines(
+				"type Rss struct {",
+				"\tChannel struct {",
+				"\t\tItem struct {",
+				"\t\t\tGUID     string `xml:\"id,attr\"`",
+				"\t\t\tHeadline string `xml:\"title\"`",
+				"\t\t} `xml:\"item\"`",
+				"\t\tPicture struct {",
+				"\t\t\tTitle string `xml:\"title\"`",
+				"\t\t} `xml:\"image\"`",
+				"\t\tTitle string `xml:\"title\"`",
+				"\t} `xml:\"channel\"`",
+				"}",
+			),
+		},
+		{
+			name: "path_renames_named_types",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithNamedTypes(true),
+				xmlstruct.WithPathRenames(map[string]string{
+					"/a/b": "Bee",
+				}),
+			},
+			xmlStr: `<a><b><c>1</c></b><d><b><c>2</c></b></d></a>`,
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tBee Bee `xml:\"b\"`",
+				"\tD   D   `xml:\"d\"`",
+				"}",
+				"",
+				"type Bee struct {",
+				"\tC int `xml:\"c\"`",
+				"}",
+				"",
+				"type D struct {",
+				"\tB Bee `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "path_renames_invalid_identifier",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithPathRenames(map[string]string{
+					"/a": "1a",
+				}),
+			},
+			xmlStr:      `<a/>`,
+			expectedErr: "1a: invalid Go identifier",
+		},
+		{
+			name: "name_conflicts_suffix",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithNameConflictStrategy(xmlstruct.NameConflictStrategySuffix),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: `<a><b id="1"><id>x</id></b></a>`,
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB struct {",
+				"\t\tID     int    `xml:\"id,attr\"`",
+				"\t\tIDElem string `xml:\"id\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "name_conflicts_namespace",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithNameConflictStrategy(xmlstruct.NameConflictStrategyNamespace),
+				xmlstruct.WithNameFunc(xmlstruct.IdentityNameFunc),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: `<a xmlns:gml="http://www.opengis.net/gml/3.2"><b id="1" gml:id="2"/></a>`,
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB struct {",
+				"\t\tGmlID int `xml:\"http://www.opengis.net/gml/3.2 id,attr\"`",
+				"\t\tID    int `xml:\"id,attr\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "name_conflicts_number",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithNameConflictStrategy(xmlstruct.NameConflictStrategyNumber),
+				xmlstruct.WithNamedTypes(true),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <b>`,
+				`    <c/>`,
+				`  </b>`,
+				`  <B>`,
+				`    <c/>`,
+				`  </B>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB  B  `xml:\"B\"`",
+				"\tB2 B2 `xml:\"b\"`",
+				"}",
+				"",
+				"type B struct {",
+				"\tC struct{} `xml:\"c\"`",
+				"}",
+				"",
+				"type B2 struct {",
+				"\tC struct{} `xml:\"c\"`",
+				"}",
+			),
+		},
+		{
+			name: "name_conflicts_unknown_strategy",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithNameConflictStrategy("rename"),
+			},
+			xmlStr:      `<a/>`,
+			expectedErr: "rename: unknown name conflict strategy",
+		},
+		{
+			name: "unexported_named_types_keywords",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithExportTypeNameFunc(xmlstruct.DefaultUnexportNameFunc),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithNamedTypes(true),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<type>`,
+				`  <string>`,
+				`    <c/>`,
+				`  </string>`,
+				`</type>`,
+			),
+			expectedStr: joinLines(
+				"type string_ struct {",
+				"\tC struct{} `xml:\"c\"`",
+				"}",
+				"",
+				"type type_ struct {",
+				"\tString string_ `xml:\"string\"`",
+				"}",
+			),
+		},
+		{
+			name: "pluralize_repeated",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithPluralizeRepeated(true),
+				xmlstruct.WithPluralOverrides(map[string]string{
+					"Wpt": "Waypoints",
+				}),
+			},
+			xmlStr: joinLines(
+				`<a>`,
+				`  <box/>`,
+				`  <box/>`,
+				`  <category/>`,
+				`  <category/>`,
+				`  <child/>`,
+				`  <child/>`,
+				`  <id/>`,
+				`  <id/>`,
+				`  <item/>`,
+				`  <item/>`,
+				`  <key/>`,
+				`  <key/>`,
+				`  <name/>`,
+				`  <wpt/>`,
+				`  <wpt/>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tBoxes      []struct{} `xml:\"box\"`",
+				"\tCategories []struct{} `xml:\"category\"`",
+				"\tChildren   []struct{} `xml:\"child\"`",
+				"\tIDs        []struct{} `xml:\"id\"`",
+				"\tItems      []struct{} `xml:\"item\"`",
+				"\tKeys       []struct{} `xml:\"key\"`",
+				"\tName       struct{}   `xml:\"name\"`",
+				"\tWaypoints  []struct{} `xml:\"wpt\"`",
+				"}",
+			),
+		},
+		{
+			name: "ascii_identifiers",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithASCIIIdentifiers(true),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<straße>`,
+				`  <café/>`,
+				`  <über größe="1"/>`,
+				`  <日本/>`,
+				`</straße>`,
+			),
+			expectedStr: joinLines(
+				"type Strasse struct {",
+				"\tCafe       struct{} `xml:\"café\"`",
+				"\tU65e5U672c struct{} `xml:\"日本\"`",
+				"\tUeber      struct {",
+				"\t\tGroesse int `xml:\"größe,attr\"`",
+				"\t} `xml:\"über\"`",
+				"}",
+			),
+		},
+		{
+			name: "html_mode",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithHTMLMode(true),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<HTML>`,
+				`  <BODY>`,
+				`    <P CLASS="x">a&nbsp;b<BR>c</P>`,
+				`    <img src=a>`,
+				`  </BODY>`,
+				`</HTML>`,
+			),
+			expectedStr: joinLines(
+				"type Html struct {",
+				"\tBody struct {",
+				"\t\tImg struct {",
+				"\t\t\tSrc string `xml:\"src,attr\"`",
+				"\t\t} `xml:\"img\"`",
+				"\t\tP struct {",
+				"\t\t\tClass    string   `xml:\"class,attr\"`",
+				"\t\t\tCharData string   `xml:\",chardata\"`",
+				"\t\t\tBr       struct{} `xml:\"br\"`",
+				"\t\t} `xml:\"p\"`",
+				"\t} `xml:\"body\"`",
+				"}",
+			),
+		},
+		{
+			name: "html_mode_path_patterns",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithExcludePaths([]string{"html/body/p/@class", "html/head"}),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithHTMLMode(true),
+				xmlstruct.WithPackageName(""),
+				xmlstruct.WithRawElements([]string{"html/body/div"}),
+			},
+			xmlStr: joinLines(
+				`<HTML>`,
+				`  <HEAD><TITLE>t</TITLE></HEAD>`,
+				`  <BODY>`,
+				`    <P CLASS="x" ID="y">a</P>`,
+				`    <DIV><SPAN>b</SPAN></DIV>`,
+				`  </BODY>`,
+				`</HTML>`,
+			),
+			expectedStr: joinLines(
+				"type Html struct {",
+				"\tBody struct {",
+				"\t\tDiv struct {",
+				"\t\t\tInnerXML string `xml:\",innerxml\"`",
+				"\t\t} `xml:\"div\"`",
+				"\t\tP struct {",
+				"\t\t\tID       string `xml:\"id,attr\"`",
+				"\t\t\tCharData string `xml:\",chardata\"`",
+				"\t\t} `xml:\"p\"`",
+				"\t} `xml:\"body\"`",
+				"}",
+			),
+		},
+		{
+			name: "entities",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<!DOCTYPE a [`,
+				`<!ELEMENT a (b*)>`,
+				`<!-- <!ENTITY c "comment"> -->`,
+				`<!ATTLIST b c CDATA "x>y">`,
+				`<!ENTITY n "noun">`,
+				`<!ENTITY % p "parameter">`,
+				`<!ENTITY s SYSTEM "s.ent">`,
+				`<!ENTITY v 'verb'>`,
+				`]>`,
+				`<a>`,
+				`  <b c="&n;">&v;</b>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB struct {",
+				"\t\tC        string `xml:\"c,attr\"`",
+				"\t\tCharData string `xml:\",chardata\"`",
+				"\t} `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "entity_enums",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithEntityEnums(true),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<!DOCTYPE a [`,
+				`<!ENTITY adj-i "adjective (keiyoushi)">`,
+				`<!ENTITY n "noun (common) (futsuumeishi)">`,
+				`<!ENTITY v5r "Godan verb with 'ru' ending">`,
+				`]>`,
+				`<a>`,
+				`  <sense>`,
+				`    <pos>&n;</pos>`,
+				`    <pos>&adj-i;</pos>`,
+				`    <gloss>x</gloss>`,
+				`  </sense>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tSense struct {",
+				"\t\tGloss string      `xml:\"gloss\"`",
+				"\t\tPos   []PosEntity `xml:\"pos\"`",
+				"\t} `xml:\"sense\"`",
+				"}",
+				"",
+				"// A PosEntity is the replacement text of an entity.",
+				"type PosEntity string",
+				"",
+				"// PosEntity values.",
+				"const (",
+				"\tPosEntityAdjI PosEntity = \"adjective (keiyoushi)\"",
+				"\tPosEntityN    PosEntity = \"noun (common) (futsuumeishi)\"",
+				")",
+			),
+		},
+		{
+			name: "entity_enums_type_name_conflict",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithEntityEnums(true),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithNamedTypes(true),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<!DOCTYPE a [`,
+				`<!ENTITY n "noun">`,
+				`]>`,
+				`<a>`,
+				`  <PosEntity>`,
+				`    <pos>&n;</pos>`,
+				`  </PosEntity>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tPosEntity PosEntity `xml:\"PosEntity\"`",
+				"}",
+				"",
+				"type PosEntity struct {",
+				"\tPos PosEntity2 `xml:\"pos\"`",
+				"}",
+				"",
+				"// A PosEntity2 is the replacement text of an entity.",
+				"type PosEntity2 string",
+				"",
+				"// PosEntity2 values.",
+				"const (",
+				"\tPosEntity2N PosEntity2 = \"noun\"",
+				")",
+			),
+		},
+		{
+			name: "entity_enums_whitespace",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithEntityEnums(true),
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			},
+			xmlStr: joinLines(
+				`<!DOCTYPE a [`,
+				`<!ENTITY n "noun">`,
+				`]>`,
+				`<a>`,
+				`  <pos>&n;</pos>`,
+				`  <pos> &n; </pos>`,
+				`</a>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tPos []PosEntity `xml:\"pos\"`",
+				"}",
+				"",
+				"// A PosEntity is the replacement text of an entity.",
+				"type PosEntity string",
+				"",
+				"// PosEntity values.",
+				"const (",
+				"\tPosEntityN  PosEntity = \"noun\"",
+				"\tPosEntityN2 PosEntity = \" noun \"",
+				")",
+			),
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
@@ -925,3 +2685,541 @@ func TestGenerator(t *testing.T) {
 func joinLines(lines ...string) string {
 	return strings.Join(lines, "\n") + "\n"
 }
+
+func TestGeneratorOutliers(t *testing.T) {
+	t.Parallel()
+
+	generator := xmlstruct.NewGenerator(
+		xmlstruct.WithTypeConfidence(0.5),
+	)
+	assert.NoError(t, generator.ObserveReader(strings.NewReader(joinLines(
+		`<a>`,
+		`  <b c="1"/>`,
+		`  <b c="x"/>`,
+		`  <b c="4"/>`,
+		`  <d>2</d>`,
+		`  <d>y</d>`,
+		`  <d>3</d>`,
+		`</a>`,
+	))))
+	_, err := generator.Generate()
+	assert.NoError(t, err)
+	assert.Equal(t, []xmlstruct.Outlier{
+		{
+			Name:   xml.Name{Local: "c"},
+			Attr:   true,
+			Value:  "x",
+			GoType: "int",
+			Line:   3,
+			Column: 13,
+		},
+		{
+			Name:   xml.Name{Local: "d"},
+			Value:  "y",
+			GoType: "int",
+			Line:   6,
+			Column: 7,
+		},
+	}, generator.Outliers())
+}
+
+func TestGeneratorPathPatternErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		options     []xmlstruct.GeneratorOption
+		expectedErr string
+	}{
+		{
+			name: "raw_elements_attr",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithRawElements([]string{"//@b"}),
+			},
+			expectedErr: "//@b: raw element pattern selects attributes",
+		},
+		{
+			name: "raw_elements_path_named_types",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithNamedTypes(true),
+				xmlstruct.WithRawElements([]string{"/a/b/c"}),
+			},
+			expectedErr: "/a/b/c: raw element path pattern used with named types",
+		},
+		{
+			name: "raw_elements_empty_step",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithRawElements([]string{"/a///b"}),
+			},
+			expectedErr: "/a///b: empty step",
+		},
+		{
+			name: "raw_elements_trailing_slash",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithRawElements([]string{"a/"}),
+			},
+			expectedErr: "a/: empty step",
+		},
+		{
+			name: "raw_elements_empty_prefix",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithRawElements([]string{":a"}),
+			},
+			expectedErr: ":a: empty step",
+		},
+		{
+			name: "exclude_paths_attr_not_last",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithExcludePaths([]string{"/a/@b/c"}),
+			},
+			expectedErr: "/a/@b/c: attribute must be the last step",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			generator := xmlstruct.NewGenerator(tc.options...)
+			err := generator.ObserveReader(strings.NewReader(`<a/>`))
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestGeneratorNameConflicts(t *testing.T) {
+	t.Parallel()
+
+	generator := xmlstruct.NewGenerator(
+		xmlstruct.WithNameConflictStrategy(xmlstruct.NameConflictStrategySuffix),
+		xmlstruct.WithNamedTypes(true),
+	)
+	assert.NoError(t, generator.ObserveReader(strings.NewReader(joinLines(
+		`<a>`,
+		`  <b id="1">`,
+		`    <id>x</id>`,
+		`  </b>`,
+		`  <B>`,
+		`    <c/>`,
+		`  </B>`,
+		`</a>`,
+	))))
+	_, err := generator.Generate()
+	assert.NoError(t, err)
+	assert.Equal(t, []xmlstruct.NameConflict{
+		{
+			Element: xml.Name{Local: "b"},
+			Type:    true,
+			OldName: "B",
+			NewName: "B2",
+		},
+		{
+			Element: xml.Name{Local: "a"},
+			Name:    xml.Name{Local: "b"},
+			OldName: "B",
+			NewName: "BElem",
+		},
+		{
+			Element: xml.Name{Local: "b"},
+			Name:    xml.Name{Local: "id"},
+			OldName: "ID",
+			NewName: "IDElem",
+		},
+	}, generator.NameConflicts())
+}
+
+func TestGeneratorHelperTypeNameConflicts(t *testing.T) {
+	t.Parallel()
+
+	generator := xmlstruct.NewGenerator(
+		xmlstruct.WithNamedTypes(true),
+		xmlstruct.WithStringSubtypes(true),
+	)
+	assert.NoError(t, generator.ObserveReader(strings.NewReader(joinLines(
+		`<a>`,
+		`  <Email address="b@example.com"/>`,
+		`</a>`,
+	))))
+	_, err := generator.Generate()
+	assert.NoError(t, err)
+	assert.Equal(t, []xmlstruct.NameConflict{
+		{
+			Type:    true,
+			OldName: "Email",
+			NewName: "Email2",
+		},
+	}, generator.NameConflicts())
+}
+
+func TestGeneratorObserveDTD(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		options     []xmlstruct.GeneratorOption
+		dtd         string
+		expectedStr string
+		expectedErr string
+	}{
+		{
+			name: "simple",
+			dtd: joinLines(
+				`<?xml version="1.0" encoding="UTF-8"?>`,
+				`<!-- <!ELEMENT comment EMPTY> -->`,
+				`<!ENTITY % text "(#PCDATA)">`,
+				`<!ELEMENT a (b, c*, d+, (e|f)?)>`,
+				`<!ELEMENT b %text;>`,
+				`<!ELEMENT c (#PCDATA|g)*>`,
+				`<!ELEMENT d EMPTY>`,
+				`<!ATTLIST d`,
+				`  id ID #REQUIRED`,
+				`  kind (x|y|z) #IMPLIED`,
+				`  xmlns CDATA #FIXED "urn:d"`,
+				`  version CDATA "1">`,
+				`<!ELEMENT e ANY>`,
+				`<!ELEMENT f (#PCDATA)>`,
+				`<!ELEMENT g (#PCDATA)>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB string `xml:\"b\"`",
+				"\tC []struct {",
+				"\t\tCharData string   `xml:\",chardata\"`",
+				"\t\tG        []string `xml:\"g\"`",
+				"\t} `xml:\"c\"`",
+				"\tD []struct {",
+				"\t\tID      string  `xml:\"id,attr\"`",
+				"\t\tKind    *string `xml:\"kind,attr\"`",
+				"\t\tVersion *string `xml:\"version,attr\"`",
+				"\t} `xml:\"d\"`",
+				"\tE *struct {",
+				"\t\tInnerXML string `xml:\",innerxml\"`",
+				"\t} `xml:\"e\"`",
+				"\tF *string `xml:\"f\"`",
+				"}",
+			),
+		},
+		{
+			name: "named_types",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithNamedTypes(true),
+			},
+			dtd: joinLines(
+				`<!ELEMENT list (item*)>`,
+				`<!ELEMENT item (#PCDATA|list)*>`,
+			),
+			expectedStr: joinLines(
+				"type Item struct {",
+				"\tCharData string `xml:\",chardata\"`",
+				"\tList     []List `xml:\"list\"`",
+				"}",
+				"",
+				"type List struct {",
+				"\tItem []Item `xml:\"item\"`",
+				"}",
+			),
+		},
+		{
+			name: "percent_in_literal",
+			dtd: joinLines(
+				`<!ENTITY % length "CDATA">`,
+				`<!ELEMENT a (img)>`,
+				`<!ELEMENT img EMPTY>`,
+				`<!ATTLIST img`,
+				`  alt CDATA '50% off'`,
+				`  width %length; "100%">`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tImg struct {",
+				"\t\tAlt   *string `xml:\"alt,attr\"`",
+				"\t\tWidth *string `xml:\"width,attr\"`",
+				"\t} `xml:\"img\"`",
+				"}",
+			),
+		},
+		{
+			name: "recursive",
+			dtd: joinLines(
+				`<!ELEMENT list (item*)>`,
+				`<!ELEMENT item (#PCDATA|list)*>`,
+			),
+			expectedErr: "list: recursive element requires named types",
+		},
+		{
+			name: "undefined_parameter_entity",
+			dtd: joinLines(
+				`<!ELEMENT a %b;>`,
+			),
+			expectedErr: "%b;: undefined parameter entity",
+		},
+		{
+			name: "invalid_content_model",
+			dtd: joinLines(
+				`<!ELEMENT a (b,c|d)>`,
+			),
+			expectedErr: "a: |d): invalid content model",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			options := append([]xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			}, tc.options...)
+			generator := xmlstruct.NewGenerator(options...)
+			err := generator.ObserveDTD(strings.NewReader(tc.dtd))
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			actual, err := generator.Generate()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStr, string(actual))
+		})
+	}
+}
+
+func TestGeneratorObserveRelaxNG(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		options     []xmlstruct.GeneratorOption
+		relaxNG     string
+		expectedStr string
+		expectedErr string
+	}{
+		{
+			name: "simple",
+			relaxNG: joinLines(
+				`<grammar xmlns="http://relaxng.org/ns/structure/1.0"`,
+				`    xmlns:a="http://relaxng.org/ns/compatibility/annotations/1.0"`,
+				`    datatypeLibrary="http://www.w3.org/2001/XMLSchema-datatypes">`,
+				`  <start>`,
+				`    <element name="a">`,
+				`      <a:documentation>The root element.</a:documentation>`,
+				`      <attribute name="id"><data type="int"/></attribute>`,
+				`      <optional>`,
+				`        <attribute name="kind">`,
+				`          <choice><value>x</value><value>y</value></choice>`,
+				`        </attribute>`,
+				`      </optional>`,
+				`      <ref name="b"/>`,
+				`      <zeroOrMore><ref name="c"/></zeroOrMore>`,
+				`      <oneOrMore><element name="d"><empty/></element></oneOrMore>`,
+				`      <optional>`,
+				`        <choice>`,
+				`          <element name="e"><data type="dateTime"/></element>`,
+				`          <element name="f"><data type="boolean"/></element>`,
+				`        </choice>`,
+				`      </optional>`,
+				`    </element>`,
+				`  </start>`,
+				`  <define name="b">`,
+				`    <element name="b"><text/></element>`,
+				`  </define>`,
+				`  <define name="c">`,
+				`    <element name="c">`,
+				`      <mixed><zeroOrMore><element name="g"><data type="decimal"/></element></zeroOrMore></mixed>`,
+				`    </element>`,
+				`  </define>`,
+				`</grammar>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB string `xml:\"b\"`",
+				"\tC []struct {",
+				"\t\tCharData string    `xml:\",chardata\"`",
+				"\t\tG        []float64 `xml:\"g\"`",
+				"\t} `xml:\"c\"`",
+				"\tD []struct{} `xml:\"d\"`",
+				"\tE *string    `xml:\"e\"`",
+				"\tF *bool      `xml:\"f\"`",
+				"}",
+			),
+		},
+		{
+			name: "attributes",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithNamedTypes(true),
+			},
+			relaxNG: joinLines(
+				`<element name="a" xmlns="http://relaxng.org/ns/structure/1.0">`,
+				`  <element name="b">`,
+				`    <attribute name="id"/>`,
+				`    <choice>`,
+				`      <attribute name="x"><data type="int"/></attribute>`,
+				`      <group>`,
+				`        <attribute name="x"><data type="int"/></attribute>`,
+				`        <attribute name="y"/>`,
+				`      </group>`,
+				`    </choice>`,
+				`  </element>`,
+				`</element>`,
+			),
+			expectedStr: joinLines(
+				"type A struct {",
+				"\tB B `xml:\"b\"`",
+				"}",
+				"",
+				"type B struct {",
+				"\tID string  `xml:\"id,attr\"`",
+				"\tX  int     `xml:\"x,attr\"`",
+				"\tY  *string `xml:\"y,attr\"`",
+				"}",
+			),
+		},
+		{
+			name: "data_types",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithSizedIntTypes(true),
+			},
+			relaxNG: joinLines(
+				`<element name="a" xmlns="http://relaxng.org/ns/structure/1.0"`,
+				`    datatypeLibrary="http://www.w3.org/2001/XMLSchema-datatypes">`,
+				`  <element name="b"><data type="dateTime"/></element>`,
+				`  <element name="c"><data type="dateTimeStamp"/></element>`,
+				`  <element name="d"><data type="double"/></element>`,
+				`  <element name="e"><data type="integer"/></element>`,
+				`  <element name="f"><data type="long"/></element>`,
+				`  <element name="g"><data type="unsignedLong"/></element>`,
+				`</element>`,
+			),
+			expectedStr: joinLines(
+				"import (",
+				"\t\"math/big\"",
+				"\t\"time\"",
+				")",
+				"",
+				"type A struct {",
+				"\tB string    `xml:\"b\"`",
+				"\tC time.Time `xml:\"c\"`",
+				"\tD float64   `xml:\"d\"`",
+				"\tE *big.Int  `xml:\"e\"`",
+				"\tF int       `xml:\"f\"`",
+				"\tG uint64    `xml:\"g\"`",
+				"}",
+			),
+		},
+		{
+			name: "decimal_type",
+			options: []xmlstruct.GeneratorOption{
+				xmlstruct.WithDecimalType("github.com/shopspring/decimal.Decimal"),
+			},
+			relaxNG: joinLines(
+				`<element name="a" xmlns="http://relaxng.org/ns/structure/1.0"`,
+				`    datatypeLibrary="http://www.w3.org/2001/XMLSchema-datatypes">`,
+				`  <element name="b"><data type="decimal"/></element>`,
+				`</element>`,
+			),
+			expectedStr: joinLines(
+				`import "github.com/shopspring/decimal"`,
+				"",
+				"type A struct {",
+				"\tB decimal.Decimal `xml:\"b\"`",
+				"}",
+			),
+		},
+		{
+			name: "wildcards",
+			relaxNG: joinLines(
+				`<grammar xmlns="http://relaxng.org/ns/structure/1.0">`,
+				`  <start>`,
+				`    <element name="a">`,
+				`      <element name="b">`,
+				`        <attribute name="id"/>`,
+				`        <zeroOrMore>`,
+				`          <attribute><anyName><except><name>id</name></except></anyName></attribute>`,
+				`        </zeroOrMore>`,
+				`        <zeroOrMore><ref name="anything"/></zeroOrMore>`,
+				`      </element>`,
+				`      <element name="c"><ref name="anything"/></element>`,
+				`    </element>`,
+				`  </start>`,
+				`  <define name="anything">`,
+				`    <element>`,
+				`      <anyName/>`,
+				`      <zeroOrMore>`,
+				`        <choice>`,
+				`          <attribute><anyName/></attribute>`,
+				`          <text/>`,
+				`          <ref name="anything"/>`,
+				`        </choice>`,
+				`      </zeroOrMore>`,
+				`    </element>`,
+				`  </define>`,
+				`</grammar>`,
+			),
+			expectedStr: joinLines(
+				`import "encoding/xml"`,
+				"",
+				"type A struct {",
+				"\tB struct {",
+				"\t\tID            string       `xml:\"id,attr\"`",
+				"\t\tExtra         []xml.Attr   `xml:\",any,attr\"`",
+				"\t\tExtraElements []AnyElement `xml:\",any\"`",
+				"\t} `xml:\"b\"`",
+				"\tC struct {",
+				"\t\tExtraElements []AnyElement `xml:\",any\"`",
+				"\t} `xml:\"c\"`",
+				"}",
+				"",
+				"// An AnyElement is an element that was not observed when generating this",
+				"// source, with its attributes and inner XML preserved.",
+				"type AnyElement struct {",
+				"\tXMLName  xml.Name",
+				"\tAttrs    []xml.Attr `xml:\",any,attr\"`",
+				"\tInnerXML string     `xml:\",innerxml\"`",
+				"}",
+			),
+		},
+		{
+			name: "recursive",
+			relaxNG: joinLines(
+				`<grammar xmlns="http://relaxng.org/ns/structure/1.0">`,
+				`  <start><ref name="list"/></start>`,
+				`  <define name="list">`,
+				`    <element name="list"><zeroOrMore><ref name="list"/></zeroOrMore></element>`,
+				`  </define>`,
+				`</grammar>`,
+			),
+			expectedErr: "list: recursive element requires named types",
+		},
+		{
+			name: "undefined_pattern",
+			relaxNG: joinLines(
+				`<grammar xmlns="http://relaxng.org/ns/structure/1.0">`,
+				`  <start><ref name="a"/></start>`,
+				`</grammar>`,
+			),
+			expectedErr: "a: undefined pattern",
+		},
+		{
+			name: "not_relaxng",
+			relaxNG: joinLines(
+				`<grammar/>`,
+			),
+			expectedErr: "grammar: not a RELAX NG schema",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			options := append([]xmlstruct.GeneratorOption{
+				xmlstruct.WithHeader(""),
+				xmlstruct.WithPackageName(""),
+			}, tc.options...)
+			generator := xmlstruct.NewGenerator(options...)
+			err := generator.ObserveRelaxNG(strings.NewReader(tc.relaxNG))
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			actual, err := generator.Generate()
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedStr, string(actual))
+		})
+	}
+}
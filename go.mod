@@ -8,10 +8,10 @@ require (
 	github.com/alecthomas/assert/v2 v2.11.0
 	github.com/spf13/pflag v1.0.6
 	golang.org/x/net v0.41.0
+	golang.org/x/text v0.26.0
 )
 
 require (
 	github.com/alecthomas/repr v0.4.0 // indirect
 	github.com/hexops/gotextdiff v1.0.3 // indirect
-	golang.org/x/text v0.26.0 // indirect
 )
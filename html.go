@@ -0,0 +1,66 @@
+package xmlstruct
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html/charset"
+)
+
+// An htmlTokenReader reads tokens from an HTML decoder with element and
+// attribute names converted to lower case.
+type htmlTokenReader struct {
+	decoder *xml.Decoder
+}
+
+// NewHTMLDecoder returns a new decoder that reads HTML-like documents from r
+// with the same settings as WithHTMLMode: it is not strict, automatically
+// closes HTML void elements, recognizes HTML entities, and converts element
+// and attribute names to lower case. Documents observed with WithHTMLMode must
+// be unmarshalled with it, for example:
+//
+//	err := xmlstruct.NewHTMLDecoder(r).Decode(&v)
+func NewHTMLDecoder(r io.Reader) *xml.Decoder {
+	return xml.NewTokenDecoder(&htmlTokenReader{
+		decoder: newHTMLDecoder(r),
+	})
+}
+
+// newHTMLDecoder returns a new decoder that reads HTML-like documents from r
+// without converting names.
+func newHTMLDecoder(r io.Reader) *xml.Decoder {
+	decoder := xml.NewDecoder(r)
+	decoder.CharsetReader = charset.NewReaderLabel
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+	return decoder
+}
+
+// Token implements encoding/xml.TokenReader.
+func (r *htmlTokenReader) Token() (xml.Token, error) {
+	token, err := r.decoder.Token()
+	switch t := token.(type) {
+	case xml.StartElement:
+		t = t.Copy()
+		t.Name = lowerCaseName(t.Name)
+		for i, attr := range t.Attr {
+			t.Attr[i].Name = lowerCaseName(attr.Name)
+		}
+		return t, err
+	case xml.EndElement:
+		t.Name = lowerCaseName(t.Name)
+		return t, err
+	default:
+		return token, err
+	}
+}
+
+// lowerCaseName returns name with its local part converted to lower case.
+func lowerCaseName(name xml.Name) xml.Name {
+	return xml.Name{
+		Space: name.Space,
+		Local: strings.ToLower(name.Local),
+	}
+}
@@ -11,7 +11,7 @@ type Arc struct {
 type ArcByCenterPoint struct {
 	EndAngle   float64 `xml:"endAngle"`
 	Pos        *string `xml:"pos"`
-	PosList    string  `xml:"posList"`
+	PosList    *string `xml:"posList"`
 	Radius     float64 `xml:"radius"`
 	StartAngle string  `xml:"startAngle"`
 }
@@ -45,10 +45,10 @@ type CurveMembers struct {
 }
 
 type Envelope struct {
-	LowerConder string   `xml:"lowerConder"`
+	LowerConder *string  `xml:"lowerConder"`
 	LowerCorner *string  `xml:"lowerCorner"`
 	Pos         []string `xml:"pos"`
-	UpperCorner string   `xml:"upperCorner"`
+	UpperCorner *string  `xml:"upperCorner"`
 }
 
 type Exterior struct {
@@ -66,8 +66,8 @@ type Interior struct {
 }
 
 type LineString struct {
-	ID      string `xml:"id,attr"`
-	PosList string `xml:"posList"`
+	ID      *string `xml:"id,attr"`
+	PosList string  `xml:"posList"`
 }
 
 type LineStringSegment struct {
@@ -104,8 +104,8 @@ type Patches struct {
 }
 
 type Point struct {
-	ID  string `xml:"id,attr"`
-	Pos string `xml:"pos"`
+	ID  *string `xml:"id,attr"`
+	Pos string  `xml:"pos"`
 }
 
 type PointMember struct {
@@ -151,7 +151,7 @@ type Surface struct {
 }
 
 type SurfaceMember struct {
-	Polygon Polygon `xml:"Polygon"`
+	Polygon *Polygon `xml:"Polygon"`
 }
 
 type TimePeriod struct {
@@ -2,7 +2,12 @@
 
 package gpx
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
 
 type GPX struct {
 	ActivePoint *struct {
@@ -19,7 +24,7 @@ type GPX struct {
 	Desc     *string `xml:"desc"`
 	Email    *string `xml:"email"`
 	Keywords *string `xml:"keywords"`
-	Metadata struct {
+	Metadata *struct {
 		Author struct {
 			Email struct {
 				Domain string `xml:"domain,attr"`
@@ -109,3 +114,264 @@ type GPX struct {
 		Type *string    `xml:"type"`
 	} `xml:"wpt"`
 }
+
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GPX) Validate() error {
+	if v.ActivePoint != nil {
+		if v.ActivePoint.Lat < 42.244646 || v.ActivePoint.Lat > 42.244646 {
+			return fmt.Errorf("gpx/active_point/@lat: %g: out of range [42.244646, 42.244646]", v.ActivePoint.Lat)
+		}
+		if v.ActivePoint.Lon < -71.468539 || v.ActivePoint.Lon > -71.468539 {
+			return fmt.Errorf("gpx/active_point/@lon: %g: out of range [-71.468539, -71.468539]", v.ActivePoint.Lon)
+		}
+	}
+	if v.Author != nil {
+		if length := utf8.RuneCountInString(*v.Author); length < 11 || length > 11 {
+			return fmt.Errorf("gpx/author: %q: length not in [11, 11]", *v.Author)
+		}
+	}
+	if v.Bounds != nil {
+		if v.Bounds.MaxLat < 42.26109 || v.Bounds.MaxLat > 42.468655 {
+			return fmt.Errorf("gpx/bounds/@maxlat: %g: out of range [42.26109, 42.468655]", v.Bounds.MaxLat)
+		}
+		if v.Bounds.MaxLon < -71.4578 || v.Bounds.MaxLon > -71.102973 {
+			return fmt.Errorf("gpx/bounds/@maxlon: %g: out of range [-71.4578, -71.102973]", v.Bounds.MaxLon)
+		}
+		if v.Bounds.MinLat < 42.223808 || v.Bounds.MinLat > 42.401051 {
+			return fmt.Errorf("gpx/bounds/@minlat: %g: out of range [42.223808, 42.401051]", v.Bounds.MinLat)
+		}
+		if v.Bounds.MinLon < -71.493169 || v.Bounds.MinLon > -71.126602 {
+			return fmt.Errorf("gpx/bounds/@minlon: %g: out of range [-71.493169, -71.126602]", v.Bounds.MinLon)
+		}
+	}
+	if v.Desc != nil {
+		if length := utf8.RuneCountInString(*v.Desc); length < 431 || length > 431 {
+			return fmt.Errorf("gpx/desc: %q: length not in [431, 431]", *v.Desc)
+		}
+	}
+	if v.Email != nil {
+		if length := utf8.RuneCountInString(*v.Email); length < 21 || length > 21 {
+			return fmt.Errorf("gpx/email: %q: length not in [21, 21]", *v.Email)
+		}
+	}
+	if v.Keywords != nil {
+		if length := utf8.RuneCountInString(*v.Keywords); length < 56 || length > 56 {
+			return fmt.Errorf("gpx/keywords: %q: length not in [56, 56]", *v.Keywords)
+		}
+	}
+	if v.Metadata != nil {
+		if length := utf8.RuneCountInString(v.Metadata.Author.Email.Domain); length < 14 || length > 14 {
+			return fmt.Errorf("gpx/metadata/author/email/@domain: %q: length not in [14, 14]", v.Metadata.Author.Email.Domain)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Author.Email.ID); length < 6 || length > 6 {
+			return fmt.Errorf("gpx/metadata/author/email/@id: %q: length not in [6, 6]", v.Metadata.Author.Email.ID)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Author.Link.Href); length < 46 || length > 46 {
+			return fmt.Errorf("gpx/metadata/author/link/@href: %q: length not in [46, 46]", v.Metadata.Author.Link.Href)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Author.Link.Text); length < 25 || length > 25 {
+			return fmt.Errorf("gpx/metadata/author/link/text: %q: length not in [25, 25]", v.Metadata.Author.Link.Text)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Author.Name); length < 10 || length > 10 {
+			return fmt.Errorf("gpx/metadata/author/name: %q: length not in [10, 10]", v.Metadata.Author.Name)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Copyright.Author); length < 10 || length > 10 {
+			return fmt.Errorf("gpx/metadata/copyright/@author: %q: length not in [10, 10]", v.Metadata.Copyright.Author)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Copyright.License); length < 43 || length > 43 {
+			return fmt.Errorf("gpx/metadata/copyright/license: %q: length not in [43, 43]", v.Metadata.Copyright.License)
+		}
+		if v.Metadata.Copyright.Year < 2004 || v.Metadata.Copyright.Year > 2004 {
+			return fmt.Errorf("gpx/metadata/copyright/year: %d: out of range [2004, 2004]", v.Metadata.Copyright.Year)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Desc); length < 309 || length > 309 {
+			return fmt.Errorf("gpx/metadata/desc: %q: length not in [309, 309]", v.Metadata.Desc)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Keywords); length < 92 || length > 92 {
+			return fmt.Errorf("gpx/metadata/keywords: %q: length not in [92, 92]", v.Metadata.Keywords)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Link.Href); length < 33 || length > 33 {
+			return fmt.Errorf("gpx/metadata/link/@href: %q: length not in [33, 33]", v.Metadata.Link.Href)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Link.Text); length < 8 || length > 8 {
+			return fmt.Errorf("gpx/metadata/link/text: %q: length not in [8, 8]", v.Metadata.Link.Text)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Link.Type); length < 9 || length > 9 {
+			return fmt.Errorf("gpx/metadata/link/type: %q: length not in [9, 9]", v.Metadata.Link.Type)
+		}
+		if length := utf8.RuneCountInString(v.Metadata.Name); length < 25 || length > 25 {
+			return fmt.Errorf("gpx/metadata/name: %q: length not in [25, 25]", v.Metadata.Name)
+		}
+	}
+	if v.Name != nil {
+		if length := utf8.RuneCountInString(*v.Name); length < 41 || length > 41 {
+			return fmt.Errorf("gpx/name: %q: length not in [41, 41]", *v.Name)
+		}
+	}
+	for _, v1 := range v.Rte {
+		if length := utf8.RuneCountInString(v1.Desc); length < 18 || length > 181 {
+			return fmt.Errorf("gpx/rte/desc: %q: length not in [18, 181]", v1.Desc)
+		}
+		if v1.Link != nil {
+			if length := utf8.RuneCountInString(v1.Link.Href); length < 57 || length > 57 {
+				return fmt.Errorf("gpx/rte/link/@href: %q: length not in [57, 57]", v1.Link.Href)
+			}
+			if length := utf8.RuneCountInString(v1.Link.Text); length < 30 || length > 30 {
+				return fmt.Errorf("gpx/rte/link/text: %q: length not in [30, 30]", v1.Link.Text)
+			}
+		}
+		if length := utf8.RuneCountInString(v1.Name); length < 8 || length > 10 {
+			return fmt.Errorf("gpx/rte/name: %q: length not in [8, 10]", v1.Name)
+		}
+		if v1.Number < 1 || v1.Number > 3 {
+			return fmt.Errorf("gpx/rte/number: %d: out of range [1, 3]", v1.Number)
+		}
+		if len(v1.RtePt) == 0 {
+			return errors.New("gpx/rte/rtept: missing")
+		}
+		for _, v2 := range v1.RtePt {
+			if v2.Lat < 42.39305 || v2.Lat > 42.46711 {
+				return fmt.Errorf("gpx/rte/rtept/@lat: %g: out of range [42.39305, 42.46711]", v2.Lat)
+			}
+			if v2.Lon < -71.122845 || v2.Lon > -71.0753 {
+				return fmt.Errorf("gpx/rte/rtept/@lon: %g: out of range [-71.122845, -71.0753]", v2.Lon)
+			}
+			if v2.Cmt != nil {
+				if length := utf8.RuneCountInString(*v2.Cmt); length < 8 || length > 30 {
+					return fmt.Errorf("gpx/rte/rtept/cmt: %q: length not in [8, 30]", *v2.Cmt)
+				}
+			}
+			if length := utf8.RuneCountInString(v2.Desc); length < 4 || length > 217 {
+				return fmt.Errorf("gpx/rte/rtept/desc: %q: length not in [4, 217]", v2.Desc)
+			}
+			if v2.Ele != nil {
+				if *v2.Ele < 6.37032 || *v2.Ele > 127.7112 {
+					return fmt.Errorf("gpx/rte/rtept/ele: %g: out of range [6.37032, 127.7112]", *v2.Ele)
+				}
+			}
+			if v2.Link != nil {
+				switch v2.Link.Href {
+				case "http://www.everydaydesign.com/ourtown/bay.html":
+				default:
+					return fmt.Errorf("gpx/rte/rtept/link/@href: %q: invalid value", v2.Link.Href)
+				}
+				switch v2.Link.Text {
+				case "Boat-building on the Mystic River":
+				default:
+					return fmt.Errorf("gpx/rte/rtept/link/text: %q: invalid value", v2.Link.Text)
+				}
+			}
+			if length := utf8.RuneCountInString(v2.Name); length < 4 || length > 10 {
+				return fmt.Errorf("gpx/rte/rtept/name: %q: length not in [4, 10]", v2.Name)
+			}
+			if length := utf8.RuneCountInString(v2.Sym); length < 3 || length > 14 {
+				return fmt.Errorf("gpx/rte/rtept/sym: %q: length not in [3, 14]", v2.Sym)
+			}
+			if length := utf8.RuneCountInString(v2.Type); length < 3 || length > 13 {
+				return fmt.Errorf("gpx/rte/rtept/type: %q: length not in [3, 13]", v2.Type)
+			}
+		}
+	}
+	for _, v1 := range v.Trk {
+		if v1.Color != nil {
+			if length := utf8.RuneCountInString(*v1.Color); length < 6 || length > 6 {
+				return fmt.Errorf("gpx/trk/color: %q: length not in [6, 6]", *v1.Color)
+			}
+		}
+		if length := utf8.RuneCountInString(v1.Desc); length < 8 || length > 423 {
+			return fmt.Errorf("gpx/trk/desc: %q: length not in [8, 423]", v1.Desc)
+		}
+		if v1.Link != nil {
+			if length := utf8.RuneCountInString(v1.Link.Href); length < 46 || length > 46 {
+				return fmt.Errorf("gpx/trk/link/@href: %q: length not in [46, 46]", v1.Link.Href)
+			}
+			if length := utf8.RuneCountInString(v1.Link.Text); length < 24 || length > 24 {
+				return fmt.Errorf("gpx/trk/link/text: %q: length not in [24, 24]", v1.Link.Text)
+			}
+		}
+		if length := utf8.RuneCountInString(v1.Name); length < 5 || length > 13 {
+			return fmt.Errorf("gpx/trk/name: %q: length not in [5, 13]", v1.Name)
+		}
+		if v1.Number < 1 || v1.Number > 17 {
+			return fmt.Errorf("gpx/trk/number: %d: out of range [1, 17]", v1.Number)
+		}
+		if len(v1.TrkSeg.TrkPt) == 0 {
+			return errors.New("gpx/trk/trkseg/trkpt: missing")
+		}
+		for _, v2 := range v1.TrkSeg.TrkPt {
+			if v2.Lat < 42.223808 || v2.Lat > 42.406046 {
+				return fmt.Errorf("gpx/trk/trkseg/trkpt/@lat: %g: out of range [42.223808, 42.406046]", v2.Lat)
+			}
+			if v2.Lon < -71.493169 || v2.Lon > -71.075256 {
+				return fmt.Errorf("gpx/trk/trkseg/trkpt/@lon: %g: out of range [-71.493169, -71.075256]", v2.Lon)
+			}
+			if v2.Ele != nil {
+				if *v2.Ele < 5.905273 || *v2.Ele > 99.633789 {
+					return fmt.Errorf("gpx/trk/trkseg/trkpt/ele: %g: out of range [5.905273, 99.633789]", *v2.Ele)
+				}
+			}
+			switch v2.Sym {
+			case "Waypoint":
+			default:
+				return fmt.Errorf("gpx/trk/trkseg/trkpt/sym: %q: invalid value", v2.Sym)
+			}
+		}
+	}
+	if v.URL != nil {
+		if length := utf8.RuneCountInString(*v.URL); length < 41 || length > 41 {
+			return fmt.Errorf("gpx/url: %q: length not in [41, 41]", *v.URL)
+		}
+	}
+	if v.URLName != nil {
+		if length := utf8.RuneCountInString(*v.URLName); length < 47 || length > 47 {
+			return fmt.Errorf("gpx/urlname: %q: length not in [47, 47]", *v.URLName)
+		}
+	}
+	if len(v.Wpt) == 0 {
+		return errors.New("gpx/wpt: missing")
+	}
+	for _, v1 := range v.Wpt {
+		if v1.Lat < 42.230185 || v1.Lat > 42.468655 {
+			return fmt.Errorf("gpx/wpt/@lat: %g: out of range [42.230185, 42.468655]", v1.Lat)
+		}
+		if v1.Lon < -71.487579 || v1.Lon > -71.074267 {
+			return fmt.Errorf("gpx/wpt/@lon: %g: out of range [-71.487579, -71.074267]", v1.Lon)
+		}
+		if v1.Cmt != nil {
+			if length := utf8.RuneCountInString(*v1.Cmt); length < 8 || length > 30 {
+				return fmt.Errorf("gpx/wpt/cmt: %q: length not in [8, 30]", *v1.Cmt)
+			}
+		}
+		if length := utf8.RuneCountInString(v1.Desc); length < 4 || length > 217 {
+			return fmt.Errorf("gpx/wpt/desc: %q: length not in [4, 217]", v1.Desc)
+		}
+		if v1.Ele != nil {
+			if *v1.Ele < 3.9624 || *v1.Ele > 128.016 {
+				return fmt.Errorf("gpx/wpt/ele: %g: out of range [3.9624, 128.016]", *v1.Ele)
+			}
+		}
+		if v1.Link != nil {
+			if length := utf8.RuneCountInString(v1.Link.Href); length < 26 || length > 55 {
+				return fmt.Errorf("gpx/wpt/link/@href: %q: length not in [26, 55]", v1.Link.Href)
+			}
+			if length := utf8.RuneCountInString(v1.Link.Text); length < 16 || length > 33 {
+				return fmt.Errorf("gpx/wpt/link/text: %q: length not in [16, 33]", v1.Link.Text)
+			}
+		}
+		if v1.Name != nil {
+			if length := utf8.RuneCountInString(*v1.Name); length < 4 || length > 10 {
+				return fmt.Errorf("gpx/wpt/name: %q: length not in [4, 10]", *v1.Name)
+			}
+		}
+		if length := utf8.RuneCountInString(v1.Sym); length < 3 || length > 15 {
+			return fmt.Errorf("gpx/wpt/sym: %q: length not in [3, 15]", v1.Sym)
+		}
+		if v1.Type != nil {
+			if length := utf8.RuneCountInString(*v1.Type); length < 3 || length > 15 {
+				return fmt.Errorf("gpx/wpt/type: %q: length not in [3, 15]", *v1.Type)
+			}
+		}
+	}
+	return nil
+}
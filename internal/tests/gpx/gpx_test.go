@@ -31,6 +31,7 @@ func TestGPX(t *testing.T) {
 			"urlname": "URLName",
 		}),
 		xmlstruct.WithPackageName("gpx"),
+		xmlstruct.WithValidateMethods(true),
 	)
 
 	filenames := []string{
@@ -61,6 +62,7 @@ func TestGPX(t *testing.T) {
 
 		var gpx gpx.GPX
 		assert.NoError(t, decoder.Decode(&gpx))
+		assert.NoError(t, gpx.Validate())
 
 		switch filename {
 		case "testdata/ashland.gpx":
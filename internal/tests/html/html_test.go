@@ -0,0 +1,52 @@
+package html_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-xmlstruct"
+	"github.com/twpayne/go-xmlstruct/internal/tests/html"
+)
+
+func TestHTML(t *testing.T) {
+	t.Parallel()
+
+	generator := xmlstruct.NewGenerator(
+		xmlstruct.WithExportRenames(map[string]string{
+			"html": "HTML",
+		}),
+		xmlstruct.WithHTMLMode(true),
+		xmlstruct.WithNamedTypes(true),
+		xmlstruct.WithPackageName("html"),
+		xmlstruct.WithPreserveOrder(true),
+		xmlstruct.WithValidateMethods(true),
+	)
+
+	assert.NoError(t, generator.ObserveFile("testdata/page.html"))
+
+	actualSource, err := generator.Generate()
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile("html.gen.go.actual", actualSource, 0o666))
+
+	expectedSource, err := os.ReadFile("html.gen.go")
+	assert.NoError(t, err)
+	assert.Equal(t, string(expectedSource), string(actualSource))
+
+	file, err := os.Open("testdata/page.html")
+	assert.NoError(t, err)
+	defer file.Close()
+	var page html.HTML
+	assert.NoError(t, xmlstruct.NewHTMLDecoder(file).Decode(&page))
+	assert.NoError(t, page.Validate())
+
+	assert.Equal(t, "Café menu", page.Head.Title)
+	assert.Equal(t, "description", page.Head.Meta.Name)
+	assert.Equal(t, "menu", page.Body.Class)
+	assert.Equal(t, 2, len(page.Body.P))
+	assert.Equal(t, "dish", page.Body.P[0].Class)
+	assert.Equal(t, "Soup of the daywith bread", page.Body.P[0].CharData)
+	assert.Equal(t, "quiche.jpg", page.Body.Img.Src)
+}
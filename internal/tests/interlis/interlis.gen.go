@@ -2,21 +2,60 @@
 
 package interlis
 
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
 type Transfer struct {
 	HeaderSection HeaderSection `xml:"HEADERSECTION"`
 	DataSection   DataSection   `xml:"DATASECTION"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Transfer) Validate() error {
+	if err := v.DataSection.Validate(); err != nil {
+		return fmt.Errorf("TRANSFER/%w", err)
+	}
+	if err := v.HeaderSection.Validate(); err != nil {
+		return fmt.Errorf("TRANSFER/%w", err)
+	}
+	return nil
+}
+
 type HeaderSection struct {
 	Sender  string   `xml:"SENDER,attr"`
 	Version float64  `xml:"VERSION,attr"`
 	Models  struct{} `xml:"MODELS"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *HeaderSection) Validate() error {
+	if length := utf8.RuneCountInString(v.Sender); length < 9 || length > 9 {
+		return fmt.Errorf("HEADERSECTION/@SENDER: %q: length not in [9, 9]", v.Sender)
+	}
+	if v.Version < 2.3 || v.Version > 2.3 {
+		return fmt.Errorf("HEADERSECTION/@VERSION: %g: out of range [2.3, 2.3]", v.Version)
+	}
+	return nil
+}
+
 type DataSection struct {
 	GM03_2_1Comprehensive_Comprehensive GM03_2_1Comprehensive_Comprehensive `xml:"GM03_2_1Comprehensive.Comprehensive"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *DataSection) Validate() error {
+	if err := v.GM03_2_1Comprehensive_Comprehensive.Validate(); err != nil {
+		return fmt.Errorf("DATASECTION/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive struct {
 	BID                                                                     string                                                                  `xml:"BID,attr"`
 	GM03_2_1Core_Core_MDMetadata                                            GM03_2_1Core_Core_MDMetadata                                            `xml:"GM03_2_1Core.Core.MD_Metadata"`
@@ -60,6 +99,222 @@ type GM03_2_1Comprehensive_Comprehensive struct {
 	GM03_2_1Comprehensive_Comprehensive_CISeries                            []GM03_2_1Comprehensive_Comprehensive_CISeries                          `xml:"GM03_2_1Comprehensive.Comprehensive.CI_Series"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive) Validate() error {
+	if length := utf8.RuneCountInString(v.BID); length < 10 || length > 10 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/@BID: %q: length not in [10, 10]", v.BID)
+	}
+	if len(v.GM03_2_1Comprehensive_Comprehensive_CICitation) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Comprehensive.Comprehensive.CI_Citation: missing")
+	}
+	for _, v1 := range v.GM03_2_1Comprehensive_Comprehensive_CICitation {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Comprehensive_Comprehensive_CICitationidentifier) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Comprehensive.Comprehensive.CI_Citationidentifier: missing")
+	}
+	for _, v1 := range v.GM03_2_1Comprehensive_Comprehensive_CICitationidentifier {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Comprehensive_Comprehensive_CISeries) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Comprehensive.Comprehensive.CI_Series: missing")
+	}
+	for _, v1 := range v.GM03_2_1Comprehensive_Comprehensive_CISeries {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if err := v.GM03_2_1Comprehensive_Comprehensive_MDDataIdentification.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Comprehensive_Comprehensive_MDDigitalTransferOptions.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Comprehensive_Comprehensive_MDFormat.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Comprehensive_Comprehensive_MDGeometricObjects.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Comprehensive_Comprehensive_MDLegalConstraints.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if len(v.GM03_2_1Comprehensive_Comprehensive_MDLegislation) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Comprehensive.Comprehensive.MD_Legislation: missing")
+	}
+	for _, v1 := range v.GM03_2_1Comprehensive_Comprehensive_MDLegislation {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Comprehensive_Comprehensive_MDMetadatalegislationInformation) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Comprehensive.Comprehensive.MD_MetadatalegislationInformation: missing")
+	}
+	for _, v1 := range v.GM03_2_1Comprehensive_Comprehensive_MDMetadatalegislationInformation {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if err := v.GM03_2_1Comprehensive_Comprehensive_MDVectorSpatialRepresentation.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Comprehensive_Comprehensive_RSIdentifier.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Comprehensive_Comprehensive_resourceConstraintsMDIdentification.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if len(v.GM03_2_1Core_Core_CIAddress) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.CI_Address: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_CIAddress {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Core_Core_CIContact) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.CI_Contact: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_CIContact {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Core_Core_CIDate) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.CI_Date: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_CIDate {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Core_Core_CIOnlineResource) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.CI_OnlineResource: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_CIOnlineResource {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Core_Core_CIResponsibleParty) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.CI_ResponsibleParty: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_CIResponsibleParty {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Core_Core_CITelephone) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.CI_Telephone: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_CITelephone {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if err := v.GM03_2_1Core_Core_DQDataQuality.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Core_Core_DQScope.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Core_Core_EXBoundingPolygon.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Core_Core_EXExtent.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if len(v.GM03_2_1Core_Core_EXExtentgeographicElement) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.EX_ExtentgeographicElement: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_EXExtentgeographicElement {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if err := v.GM03_2_1Core_Core_EXGeographicBoundingBox.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Core_Core_LILineage.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Core_Core_MDDistribution.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Core_Core_MDDistributiondistributionFormat.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Core_Core_MDIdentificationpointOfContact.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if len(v.GM03_2_1Core_Core_MDIdentifier) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.MD_Identifier: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_MDIdentifier {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Core_Core_MDKeywords) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.MD_Keywords: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_MDKeywords {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if err := v.GM03_2_1Core_Core_MDMetadata.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Core_Core_MDMetadatacontact.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if err := v.GM03_2_1Core_Core_MDReferenceSystem.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	if len(v.GM03_2_1Core_Core_MDRepresentativeFraction) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.MD_RepresentativeFraction: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_MDRepresentativeFraction {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Core_Core_MDResolution) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.MD_Resolution: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_MDResolution {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Core_Core_MDThesaurus) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.MD_Thesaurus: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_MDThesaurus {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if len(v.GM03_2_1Core_Core_descriptiveKeywordsMDIdentification) == 0 {
+		return errors.New("GM03_2_1Comprehensive.Comprehensive/GM03_2_1Core.Core.descriptiveKeywordsMD_Identification: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_descriptiveKeywordsMDIdentification {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+		}
+	}
+	if err := v.GM03_2_1Core_Core_referenceSystemInfoMDMetadata.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDMetadata struct {
 	TID                  string           `xml:"TID,attr"`
 	FileIdentifier       string           `xml:"fileIdentifier"`
@@ -71,54 +326,200 @@ type GM03_2_1Core_Core_MDMetadata struct {
 	DistributionInfo     DistributionInfo `xml:"distributionInfo"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDMetadata) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Metadata/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if err := v.CharacterSet.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Metadata/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.DateStamp); length < 19 || length > 19 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Metadata/dateStamp: %q: length not in [19, 19]", v.DateStamp)
+	}
+	if err := v.DistributionInfo.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Metadata/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.FileIdentifier); length < 36 || length > 36 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Metadata/fileIdentifier: %q: length not in [36, 36]", v.FileIdentifier)
+	}
+	if err := v.HierarchyLevel.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Metadata/%w", err)
+	}
+	if err := v.Language.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Metadata/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.MetadataStandardName); length < 7 || length > 7 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Metadata/metadataStandardName: %q: length not in [7, 7]", v.MetadataStandardName)
+	}
+	return nil
+}
+
 type Language struct {
 	CharData                 string                     `xml:",chardata"`
 	CodeISO_LanguageCodeISO_ []CodeISO_LanguageCodeISO_ `xml:"CodeISO.LanguageCodeISO_"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Language) Validate() error {
+	for _, v1 := range v.CodeISO_LanguageCodeISO_ {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("language/%w", err)
+		}
+	}
+	return nil
+}
+
 type CharacterSet struct {
-	CharData                              string                                `xml:",chardata"`
-	GM03_2_1Core_Core_MDCharacterSetCode_ GM03_2_1Core_Core_MDCharacterSetCode_ `xml:"GM03_2_1Core.Core.MD_CharacterSetCode_"`
+	CharData                              string                                 `xml:",chardata"`
+	GM03_2_1Core_Core_MDCharacterSetCode_ *GM03_2_1Core_Core_MDCharacterSetCode_ `xml:"GM03_2_1Core.Core.MD_CharacterSetCode_"`
+}
+
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *CharacterSet) Validate() error {
+	if v.GM03_2_1Core_Core_MDCharacterSetCode_ != nil {
+		if err := v.GM03_2_1Core_Core_MDCharacterSetCode_.Validate(); err != nil {
+			return fmt.Errorf("characterSet/%w", err)
+		}
+	}
+	return nil
 }
 
 type HierarchyLevel struct {
 	GM03_2_1Core_Core_MDScopeCode_ GM03_2_1Core_Core_MDScopeCode_ `xml:"GM03_2_1Core.Core.MD_ScopeCode_"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *HierarchyLevel) Validate() error {
+	if err := v.GM03_2_1Core_Core_MDScopeCode_.Validate(); err != nil {
+		return fmt.Errorf("hierarchyLevel/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDScopeCode_ struct {
 	Value string `xml:"value"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDScopeCode_) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 2 || length > 23 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_ScopeCode_/value: %q: length not in [2, 23]", v.Value)
+	}
+	return nil
+}
+
 type DistributionInfo struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *DistributionInfo) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 12 || length > 12 {
+		return fmt.Errorf("distributionInfo/@REF: %q: length not in [12, 12]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDDistribution struct {
 	TID string `xml:"TID,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDDistribution) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Distribution/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDDistributiondistributionFormat struct {
 	MDDistribution     MDDistribution     `xml:"MD_Distribution"`
 	DistributionFormat DistributionFormat `xml:"distributionFormat"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDDistributiondistributionFormat) Validate() error {
+	if err := v.DistributionFormat.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_DistributiondistributionFormat/%w", err)
+	}
+	if err := v.MDDistribution.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_DistributiondistributionFormat/%w", err)
+	}
+	return nil
+}
+
 type MDDistribution struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *MDDistribution) Validate() error {
+	switch v.REF {
+	case "xN2143471874":
+	default:
+		return fmt.Errorf("MD_Distribution/@REF: %q: invalid value", v.REF)
+	}
+	return nil
+}
+
 type DistributionFormat struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *DistributionFormat) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 12 || length > 12 {
+		return fmt.Errorf("distributionFormat/@REF: %q: length not in [12, 12]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_MDFormat struct {
 	TID     string  `xml:"TID,attr"`
 	Name    Name    `xml:"name"`
 	Version float64 `xml:"version"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_MDFormat) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_Format/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if err := v.Name.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_Format/%w", err)
+	}
+	if v.Version < 2.2 || v.Version > 2.2 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_Format/version: %g: out of range [2.2, 2.2]", v.Version)
+	}
+	return nil
+}
+
 type Name struct {
-	CharData                     string                       `xml:",chardata"`
-	GM03_2_1Core_Core_PTFreeText GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
+	CharData                     string                        `xml:",chardata"`
+	GM03_2_1Core_Core_PTFreeText *GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
+}
+
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Name) Validate() error {
+	if v.GM03_2_1Core_Core_PTFreeText != nil {
+		if err := v.GM03_2_1Core_Core_PTFreeText.Validate(); err != nil {
+			return fmt.Errorf("name/%w", err)
+		}
+	}
+	return nil
 }
 
 type GM03_2_1Comprehensive_Comprehensive_MDDigitalTransferOptions struct {
@@ -126,6 +527,18 @@ type GM03_2_1Comprehensive_Comprehensive_MDDigitalTransferOptions struct {
 	MDDistribution MDDistribution `xml:"MD_Distribution"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_MDDigitalTransferOptions) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_DigitalTransferOptions/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if err := v.MDDistribution.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_DigitalTransferOptions/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_CIOnlineResource struct {
 	TID                      string                   `xml:"TID,attr"`
 	Protocol                 string                   `xml:"protocol"`
@@ -136,66 +549,251 @@ type GM03_2_1Core_Core_CIOnlineResource struct {
 	Function                 *string                  `xml:"function"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_CIOnlineResource) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 10 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_OnlineResource/@TID: %q: length not in [10, 12]", v.TID)
+	}
+	if err := v.Description.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_OnlineResource/%w", err)
+	}
+	if v.Function != nil {
+		switch *v.Function {
+		case "download", "information":
+		default:
+			return fmt.Errorf("GM03_2_1Core.Core.CI_OnlineResource/function: %q: invalid value", *v.Function)
+		}
+	}
+	if err := v.Linkage.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_OnlineResource/%w", err)
+	}
+	if err := v.MDDigitalTransferOptions.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_OnlineResource/%w", err)
+	}
+	if v.Name != nil {
+		if err := v.Name.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Core.Core.CI_OnlineResource/%w", err)
+		}
+	}
+	if length := utf8.RuneCountInString(v.Protocol); length < 7 || length > 28 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_OnlineResource/protocol: %q: length not in [7, 28]", v.Protocol)
+	}
+	return nil
+}
+
 type Description struct {
 	GM03_2_1Core_Core_PTFreeText GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Description) Validate() error {
+	if err := v.GM03_2_1Core_Core_PTFreeText.Validate(); err != nil {
+		return fmt.Errorf("description/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_PTFreeText struct {
 	TextGroup TextGroup `xml:"textGroup"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_PTFreeText) Validate() error {
+	if err := v.TextGroup.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.PT_FreeText/%w", err)
+	}
+	return nil
+}
+
 type TextGroup struct {
 	GM03_2_1Core_Core_PTGroup []GM03_2_1Core_Core_PTGroup `xml:"GM03_2_1Core.Core.PT_Group"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *TextGroup) Validate() error {
+	if len(v.GM03_2_1Core_Core_PTGroup) == 0 {
+		return errors.New("textGroup/GM03_2_1Core.Core.PT_Group: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_PTGroup {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("textGroup/%w", err)
+		}
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_PTGroup struct {
 	Language  Language `xml:"language"`
 	PlainText string   `xml:"plainText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_PTGroup) Validate() error {
+	if err := v.Language.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.PT_Group/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.PlainText); length < 3 || length > 1201 {
+		return fmt.Errorf("GM03_2_1Core.Core.PT_Group/plainText: %q: length not in [3, 1201]", v.PlainText)
+	}
+	return nil
+}
+
 type Linkage struct {
 	GM03_2_1Core_Core_PTFreeURL GM03_2_1Core_Core_PTFreeURL `xml:"GM03_2_1Core.Core.PT_FreeURL"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Linkage) Validate() error {
+	if err := v.GM03_2_1Core_Core_PTFreeURL.Validate(); err != nil {
+		return fmt.Errorf("linkage/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_PTFreeURL struct {
 	URLGroup URLGroup `xml:"URLGroup"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_PTFreeURL) Validate() error {
+	if err := v.URLGroup.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.PT_FreeURL/%w", err)
+	}
+	return nil
+}
+
 type URLGroup struct {
 	GM03_2_1Core_Core_PTURLGroup []GM03_2_1Core_Core_PTURLGroup `xml:"GM03_2_1Core.Core.PT_URLGroup"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *URLGroup) Validate() error {
+	if len(v.GM03_2_1Core_Core_PTURLGroup) == 0 {
+		return errors.New("URLGroup/GM03_2_1Core.Core.PT_URLGroup: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_PTURLGroup {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("URLGroup/%w", err)
+		}
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_PTURLGroup struct {
 	Language Language `xml:"language"`
 	PlainURL string   `xml:"plainURL"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_PTURLGroup) Validate() error {
+	if err := v.Language.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.PT_URLGroup/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.PlainURL); length < 43 || length > 148 {
+		return fmt.Errorf("GM03_2_1Core.Core.PT_URLGroup/plainURL: %q: length not in [43, 148]", v.PlainURL)
+	}
+	return nil
+}
+
 type MDDigitalTransferOptions struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *MDDigitalTransferOptions) Validate() error {
+	switch v.REF {
+	case "xN1205086390":
+	default:
+		return fmt.Errorf("MD_DigitalTransferOptions/@REF: %q: invalid value", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDMetadatacontact struct {
 	Contact    Contact    `xml:"contact"`
 	MDMetadata MDMetadata `xml:"MD_Metadata"`
 	Role       Role       `xml:"role"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDMetadatacontact) Validate() error {
+	if err := v.Contact.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Metadatacontact/%w", err)
+	}
+	if err := v.MDMetadata.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Metadatacontact/%w", err)
+	}
+	if err := v.Role.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Metadatacontact/%w", err)
+	}
+	return nil
+}
+
 type Contact struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Contact) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 12 || length > 12 {
+		return fmt.Errorf("contact/@REF: %q: length not in [12, 12]", v.REF)
+	}
+	return nil
+}
+
 type MDMetadata struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *MDMetadata) Validate() error {
+	switch v.REF {
+	case "xN1830778881":
+	default:
+		return fmt.Errorf("MD_Metadata/@REF: %q: invalid value", v.REF)
+	}
+	return nil
+}
+
 type Role struct {
 	GM03_2_1Core_Core_CIRoleCode_ GM03_2_1Core_Core_CIRoleCode_ `xml:"GM03_2_1Core.Core.CI_RoleCode_"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Role) Validate() error {
+	if err := v.GM03_2_1Core_Core_CIRoleCode_.Validate(); err != nil {
+		return fmt.Errorf("role/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_CIRoleCode_ struct {
 	Value string `xml:"value"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_CIRoleCode_) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 2 || length > 23 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_RoleCode_/value: %q: length not in [2, 23]", v.Value)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_CIResponsibleParty struct {
 	TID                     string                  `xml:"TID,attr"`
 	IndividualFirstName     *string                 `xml:"individualFirstName"`
@@ -209,34 +807,135 @@ type GM03_2_1Core_Core_CIResponsibleParty struct {
 	ContactInfo             ContactInfo             `xml:"contactInfo"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_CIResponsibleParty) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_ResponsibleParty/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if err := v.Address.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_ResponsibleParty/%w", err)
+	}
+	if err := v.ContactInfo.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_ResponsibleParty/%w", err)
+	}
+	if err := v.ElectronicalMailAddress.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_ResponsibleParty/%w", err)
+	}
+	if v.IndividualFirstName != nil {
+		if length := utf8.RuneCountInString(*v.IndividualFirstName); length < 6 || length > 6 {
+			return fmt.Errorf("GM03_2_1Core.Core.CI_ResponsibleParty/individualFirstName: %q: length not in [6, 6]", *v.IndividualFirstName)
+		}
+	}
+	if length := utf8.RuneCountInString(v.IndividualLastName); length < 10 || length > 24 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_ResponsibleParty/individualLastName: %q: length not in [10, 24]", v.IndividualLastName)
+	}
+	if err := v.Linkage.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_ResponsibleParty/%w", err)
+	}
+	if err := v.OrganisationAcronym.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_ResponsibleParty/%w", err)
+	}
+	if err := v.OrganisationName.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_ResponsibleParty/%w", err)
+	}
+	if err := v.PositionName.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_ResponsibleParty/%w", err)
+	}
+	return nil
+}
+
 type ElectronicalMailAddress struct {
 	GM03_2_1Core_Core_URL_ GM03_2_1Core_Core_URL_ `xml:"GM03_2_1Core.Core.URL_"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *ElectronicalMailAddress) Validate() error {
+	if err := v.GM03_2_1Core_Core_URL_.Validate(); err != nil {
+		return fmt.Errorf("electronicalMailAddress/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_URL_ struct {
 	Value string `xml:"value"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_URL_) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 2 || length > 23 {
+		return fmt.Errorf("GM03_2_1Core.Core.URL_/value: %q: length not in [2, 23]", v.Value)
+	}
+	return nil
+}
+
 type OrganisationName struct {
 	GM03_2_1Core_Core_PTFreeText GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *OrganisationName) Validate() error {
+	if err := v.GM03_2_1Core_Core_PTFreeText.Validate(); err != nil {
+		return fmt.Errorf("organisationName/%w", err)
+	}
+	return nil
+}
+
 type PositionName struct {
 	GM03_2_1Core_Core_PTFreeText GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *PositionName) Validate() error {
+	if err := v.GM03_2_1Core_Core_PTFreeText.Validate(); err != nil {
+		return fmt.Errorf("positionName/%w", err)
+	}
+	return nil
+}
+
 type OrganisationAcronym struct {
 	GM03_2_1Core_Core_PTFreeText GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *OrganisationAcronym) Validate() error {
+	if err := v.GM03_2_1Core_Core_PTFreeText.Validate(); err != nil {
+		return fmt.Errorf("organisationAcronym/%w", err)
+	}
+	return nil
+}
+
 type Address struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Address) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 11 || length > 12 {
+		return fmt.Errorf("address/@REF: %q: length not in [11, 12]", v.REF)
+	}
+	return nil
+}
+
 type ContactInfo struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *ContactInfo) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 11 || length > 12 {
+		return fmt.Errorf("contactInfo/@REF: %q: length not in [11, 12]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_CIAddress struct {
 	TID        string  `xml:"TID,attr"`
 	PostalCode string  `xml:"postalCode"`
@@ -244,15 +943,53 @@ type GM03_2_1Core_Core_CIAddress struct {
 	Country    Country `xml:"country"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_CIAddress) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 11 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Address/@TID: %q: length not in [11, 12]", v.TID)
+	}
+	if length := utf8.RuneCountInString(v.City); length < 4 || length > 4 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Address/city: %q: length not in [4, 4]", v.City)
+	}
+	if err := v.Country.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Address/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.PostalCode); length < 4 || length > 4 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Address/postalCode: %q: length not in [4, 4]", v.PostalCode)
+	}
+	return nil
+}
+
 type Country struct {
-	CharData                string                  `xml:",chardata"`
-	CodeISO_CountryCodeISO_ CodeISO_CountryCodeISO_ `xml:"CodeISO.CountryCodeISO_"`
+	CharData                string                   `xml:",chardata"`
+	CodeISO_CountryCodeISO_ *CodeISO_CountryCodeISO_ `xml:"CodeISO.CountryCodeISO_"`
+}
+
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Country) Validate() error {
+	if v.CodeISO_CountryCodeISO_ != nil {
+		if err := v.CodeISO_CountryCodeISO_.Validate(); err != nil {
+			return fmt.Errorf("country/%w", err)
+		}
+	}
+	return nil
 }
 
 type GM03_2_1Core_Core_CIContact struct {
 	TID string `xml:"TID,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_CIContact) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 11 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Contact/@TID: %q: length not in [11, 12]", v.TID)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_CITelephone struct {
 	TID                string             `xml:"TID,attr"`
 	Number             string             `xml:"number"`
@@ -260,53 +997,184 @@ type GM03_2_1Core_Core_CITelephone struct {
 	CIResponsibleParty CIResponsibleParty `xml:"CI_ResponsibleParty"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_CITelephone) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 11 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Telephone/@TID: %q: length not in [11, 12]", v.TID)
+	}
+	if err := v.CIResponsibleParty.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Telephone/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.Number); length < 19 || length > 19 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Telephone/number: %q: length not in [19, 19]", v.Number)
+	}
+	if length := utf8.RuneCountInString(v.NumberType); length < 9 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Telephone/numberType: %q: length not in [9, 12]", v.NumberType)
+	}
+	return nil
+}
+
 type CIResponsibleParty struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *CIResponsibleParty) Validate() error {
+	switch v.REF {
+	case "xN1076904849", "xN1955444031":
+	default:
+		return fmt.Errorf("CI_ResponsibleParty/@REF: %q: invalid value", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_MDVectorSpatialRepresentation struct {
 	Gml320     string     `xml:"gml320,attr"`
 	TID        string     `xml:"TID,attr"`
 	MDMetadata MDMetadata `xml:"MD_Metadata"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_MDVectorSpatialRepresentation) Validate() error {
+	if length := utf8.RuneCountInString(v.Gml320); length < 26 || length > 26 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_VectorSpatialRepresentation/@gml320: %q: length not in [26, 26]", v.Gml320)
+	}
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_VectorSpatialRepresentation/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if err := v.MDMetadata.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_VectorSpatialRepresentation/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_MDGeometricObjects struct {
 	TID                           string                        `xml:"TID,attr"`
 	GeometricObjectType           string                        `xml:"geometricObjectType"`
 	MDVectorSpatialRepresentation MDVectorSpatialRepresentation `xml:"MD_VectorSpatialRepresentation"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_MDGeometricObjects) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_GeometricObjects/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if length := utf8.RuneCountInString(v.GeometricObjectType); length < 9 || length > 9 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_GeometricObjects/geometricObjectType: %q: length not in [9, 9]", v.GeometricObjectType)
+	}
+	if err := v.MDVectorSpatialRepresentation.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_GeometricObjects/%w", err)
+	}
+	return nil
+}
+
 type MDVectorSpatialRepresentation struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *MDVectorSpatialRepresentation) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 12 || length > 12 {
+		return fmt.Errorf("MD_VectorSpatialRepresentation/@REF: %q: length not in [12, 12]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_referenceSystemInfoMDMetadata struct {
 	ReferenceSystemInfo ReferenceSystemInfo `xml:"referenceSystemInfo"`
 	MDMetadata          MDMetadata          `xml:"MD_Metadata"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_referenceSystemInfoMDMetadata) Validate() error {
+	if err := v.MDMetadata.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.referenceSystemInfoMD_Metadata/%w", err)
+	}
+	if err := v.ReferenceSystemInfo.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.referenceSystemInfoMD_Metadata/%w", err)
+	}
+	return nil
+}
+
 type ReferenceSystemInfo struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *ReferenceSystemInfo) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 16 || length > 16 {
+		return fmt.Errorf("referenceSystemInfo/@REF: %q: length not in [16, 16]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDReferenceSystem struct {
 	TID                       string                    `xml:"TID,attr"`
 	ReferenceSystemIdentifier ReferenceSystemIdentifier `xml:"referenceSystemIdentifier"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDReferenceSystem) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 16 || length > 16 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_ReferenceSystem/@TID: %q: length not in [16, 16]", v.TID)
+	}
+	if err := v.ReferenceSystemIdentifier.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_ReferenceSystem/%w", err)
+	}
+	return nil
+}
+
 type ReferenceSystemIdentifier struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *ReferenceSystemIdentifier) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 20 || length > 20 {
+		return fmt.Errorf("referenceSystemIdentifier/@REF: %q: length not in [20, 20]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_RSIdentifier struct {
 	TID  string `xml:"TID,attr"`
 	Code Code   `xml:"code"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_RSIdentifier) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 20 || length > 20 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.RS_Identifier/@TID: %q: length not in [20, 20]", v.TID)
+	}
+	if err := v.Code.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.RS_Identifier/%w", err)
+	}
+	return nil
+}
+
 type Code struct {
 	GM03_2_1Core_Core_PTFreeText GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Code) Validate() error {
+	if err := v.GM03_2_1Core_Core_PTFreeText.Validate(); err != nil {
+		return fmt.Errorf("code/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_MDDataIdentification struct {
 	TID                       string                    `xml:"TID,attr"`
 	Status                    Status                    `xml:"status"`
@@ -319,52 +1187,202 @@ type GM03_2_1Comprehensive_Comprehensive_MDDataIdentification struct {
 	TopicCategory             TopicCategory             `xml:"topicCategory"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_MDDataIdentification) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_DataIdentification/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if err := v.Abstract.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_DataIdentification/%w", err)
+	}
+	if err := v.CharacterSet.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_DataIdentification/%w", err)
+	}
+	if err := v.Citation.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_DataIdentification/%w", err)
+	}
+	if err := v.Language.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_DataIdentification/%w", err)
+	}
+	if err := v.MDMetadata.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_DataIdentification/%w", err)
+	}
+	if err := v.SpatialRepresentationType.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_DataIdentification/%w", err)
+	}
+	if err := v.Status.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_DataIdentification/%w", err)
+	}
+	if err := v.TopicCategory.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_DataIdentification/%w", err)
+	}
+	return nil
+}
+
 type Status struct {
 	GM03_2_1Core_Core_MDProgressCode_ GM03_2_1Core_Core_MDProgressCode_ `xml:"GM03_2_1Core.Core.MD_ProgressCode_"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Status) Validate() error {
+	if err := v.GM03_2_1Core_Core_MDProgressCode_.Validate(); err != nil {
+		return fmt.Errorf("status/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDProgressCode_ struct {
 	Value string `xml:"value"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDProgressCode_) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 2 || length > 23 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_ProgressCode_/value: %q: length not in [2, 23]", v.Value)
+	}
+	return nil
+}
+
 type Abstract struct {
 	GM03_2_1Core_Core_PTFreeText GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Abstract) Validate() error {
+	if err := v.GM03_2_1Core_Core_PTFreeText.Validate(); err != nil {
+		return fmt.Errorf("abstract/%w", err)
+	}
+	return nil
+}
+
 type Citation struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Citation) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 11 || length > 12 {
+		return fmt.Errorf("citation/@REF: %q: length not in [11, 12]", v.REF)
+	}
+	return nil
+}
+
 type SpatialRepresentationType struct {
 	GM03_2_1Core_Core_MDSpatialRepresentationTypeCode_ GM03_2_1Core_Core_MDSpatialRepresentationTypeCode_ `xml:"GM03_2_1Core.Core.MD_SpatialRepresentationTypeCode_"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *SpatialRepresentationType) Validate() error {
+	if err := v.GM03_2_1Core_Core_MDSpatialRepresentationTypeCode_.Validate(); err != nil {
+		return fmt.Errorf("spatialRepresentationType/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDSpatialRepresentationTypeCode_ struct {
 	Value string `xml:"value"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDSpatialRepresentationTypeCode_) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 2 || length > 23 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_SpatialRepresentationTypeCode_/value: %q: length not in [2, 23]", v.Value)
+	}
+	return nil
+}
+
 type CodeISO_LanguageCodeISO_ struct {
 	Value string `xml:"value"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *CodeISO_LanguageCodeISO_) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 2 || length > 23 {
+		return fmt.Errorf("CodeISO.LanguageCodeISO_/value: %q: length not in [2, 23]", v.Value)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDCharacterSetCode_ struct {
 	Value string `xml:"value"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDCharacterSetCode_) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 2 || length > 23 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_CharacterSetCode_/value: %q: length not in [2, 23]", v.Value)
+	}
+	return nil
+}
+
 type TopicCategory struct {
 	GM03_2_1Core_Core_MDTopicCategoryCode_ GM03_2_1Core_Core_MDTopicCategoryCode_ `xml:"GM03_2_1Core.Core.MD_TopicCategoryCode_"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *TopicCategory) Validate() error {
+	if err := v.GM03_2_1Core_Core_MDTopicCategoryCode_.Validate(); err != nil {
+		return fmt.Errorf("topicCategory/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDTopicCategoryCode_ struct {
 	Value string `xml:"value"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDTopicCategoryCode_) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 2 || length > 23 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_TopicCategoryCode_/value: %q: length not in [2, 23]", v.Value)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_CICitation struct {
 	TID                  string                `xml:"TID,attr"`
 	Title                Title                 `xml:"title"`
 	AlternateTitle       *AlternateTitle       `xml:"alternateTitle"`
 	OtherCitationDetails *OtherCitationDetails `xml:"otherCitationDetails"`
-	Series               Series                `xml:"series"`
+	Series               *Series               `xml:"series"`
+}
+
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_CICitation) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 11 || length > 12 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.CI_Citation/@TID: %q: length not in [11, 12]", v.TID)
+	}
+	if v.AlternateTitle != nil {
+		if err := v.AlternateTitle.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.CI_Citation/%w", err)
+		}
+	}
+	if v.OtherCitationDetails != nil {
+		if err := v.OtherCitationDetails.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.CI_Citation/%w", err)
+		}
+	}
+	if v.Series != nil {
+		if err := v.Series.Validate(); err != nil {
+			return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.CI_Citation/%w", err)
+		}
+	}
+	if err := v.Title.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.CI_Citation/%w", err)
+	}
+	return nil
 }
 
 type Title struct {
@@ -372,10 +1390,35 @@ type Title struct {
 	GM03_2_1Core_Core_PTFreeText *GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Title) Validate() error {
+	if v.REF != nil {
+		if length := utf8.RuneCountInString(*v.REF); length < 0 || length > 12 {
+			return fmt.Errorf("title/@REF: %q: length not in [0, 12]", *v.REF)
+		}
+	}
+	if v.GM03_2_1Core_Core_PTFreeText != nil {
+		if err := v.GM03_2_1Core_Core_PTFreeText.Validate(); err != nil {
+			return fmt.Errorf("title/%w", err)
+		}
+	}
+	return nil
+}
+
 type AlternateTitle struct {
 	GM03_2_1Core_Core_PTFreeText GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *AlternateTitle) Validate() error {
+	if err := v.GM03_2_1Core_Core_PTFreeText.Validate(); err != nil {
+		return fmt.Errorf("alternateTitle/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_CIDate struct {
 	TID        string     `xml:"TID,attr"`
 	Date       string     `xml:"date"`
@@ -383,131 +1426,463 @@ type GM03_2_1Core_Core_CIDate struct {
 	CICitation CICitation `xml:"CI_Citation"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_CIDate) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 24 || length > 26 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Date/@TID: %q: length not in [24, 26]", v.TID)
+	}
+	if err := v.CICitation.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Date/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.Date); length < 10 || length > 10 {
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Date/date: %q: length not in [10, 10]", v.Date)
+	}
+	switch v.DateType {
+	case "creation", "publication":
+	default:
+		return fmt.Errorf("GM03_2_1Core.Core.CI_Date/dateType: %q: invalid value", v.DateType)
+	}
+	return nil
+}
+
 type CICitation struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *CICitation) Validate() error {
+	switch v.REF {
+	case "xN1335090261", "xN1679820576", "xN202985067", "xN2061352305", "xN753832492":
+	default:
+		return fmt.Errorf("CI_Citation/@REF: %q: invalid value", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_CICitationidentifier struct {
 	Identifier Identifier `xml:"identifier"`
 	CICitation CICitation `xml:"CI_Citation"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_CICitationidentifier) Validate() error {
+	if err := v.CICitation.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.CI_Citationidentifier/%w", err)
+	}
+	if err := v.Identifier.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.CI_Citationidentifier/%w", err)
+	}
+	return nil
+}
+
 type Identifier struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Identifier) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 1 || length > 12 {
+		return fmt.Errorf("identifier/@REF: %q: length not in [1, 12]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDIdentifier struct {
 	TID  string `xml:"TID,attr"`
 	Code Code   `xml:"code"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDIdentifier) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Identifier/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if err := v.Code.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Identifier/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDIdentificationpointOfContact struct {
 	PointOfContact   PointOfContact   `xml:"pointOfContact"`
 	MDIdentification MDIdentification `xml:"MD_Identification"`
 	Role             Role             `xml:"role"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDIdentificationpointOfContact) Validate() error {
+	if err := v.MDIdentification.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_IdentificationpointOfContact/%w", err)
+	}
+	if err := v.PointOfContact.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_IdentificationpointOfContact/%w", err)
+	}
+	if err := v.Role.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_IdentificationpointOfContact/%w", err)
+	}
+	return nil
+}
+
 type PointOfContact struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *PointOfContact) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 12 || length > 12 {
+		return fmt.Errorf("pointOfContact/@REF: %q: length not in [12, 12]", v.REF)
+	}
+	return nil
+}
+
 type MDIdentification struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *MDIdentification) Validate() error {
+	switch v.REF {
+	case "xN1949928464":
+	default:
+		return fmt.Errorf("MD_Identification/@REF: %q: invalid value", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_descriptiveKeywordsMDIdentification struct {
 	DescriptiveKeywords DescriptiveKeywords `xml:"descriptiveKeywords"`
 	MDIdentification    MDIdentification    `xml:"MD_Identification"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_descriptiveKeywordsMDIdentification) Validate() error {
+	if err := v.DescriptiveKeywords.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.descriptiveKeywordsMD_Identification/%w", err)
+	}
+	if err := v.MDIdentification.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.descriptiveKeywordsMD_Identification/%w", err)
+	}
+	return nil
+}
+
 type DescriptiveKeywords struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *DescriptiveKeywords) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 10 || length > 12 {
+		return fmt.Errorf("descriptiveKeywords/@REF: %q: length not in [10, 12]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDKeywords struct {
 	TID       string    `xml:"TID,attr"`
 	Keyword   Keyword   `xml:"keyword"`
 	Thesaurus Thesaurus `xml:"thesaurus"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDKeywords) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 10 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Keywords/@TID: %q: length not in [10, 12]", v.TID)
+	}
+	if err := v.Keyword.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Keywords/%w", err)
+	}
+	if err := v.Thesaurus.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Keywords/%w", err)
+	}
+	return nil
+}
+
 type Keyword struct {
 	GM03_2_1Core_Core_PTFreeText []GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Keyword) Validate() error {
+	if len(v.GM03_2_1Core_Core_PTFreeText) == 0 {
+		return errors.New("keyword/GM03_2_1Core.Core.PT_FreeText: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_PTFreeText {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("keyword/%w", err)
+		}
+	}
+	return nil
+}
+
 type Thesaurus struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Thesaurus) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 11 || length > 12 {
+		return fmt.Errorf("thesaurus/@REF: %q: length not in [11, 12]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDThesaurus struct {
 	TID      string   `xml:"TID,attr"`
 	Citation Citation `xml:"citation"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDThesaurus) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 11 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Thesaurus/@TID: %q: length not in [11, 12]", v.TID)
+	}
+	if err := v.Citation.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Thesaurus/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_resourceConstraintsMDIdentification struct {
 	ResourceConstraints ResourceConstraints `xml:"resourceConstraints"`
 	MDIdentification    MDIdentification    `xml:"MD_Identification"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_resourceConstraintsMDIdentification) Validate() error {
+	if err := v.MDIdentification.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.resourceConstraintsMD_Identification/%w", err)
+	}
+	if err := v.ResourceConstraints.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.resourceConstraintsMD_Identification/%w", err)
+	}
+	return nil
+}
+
 type ResourceConstraints struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *ResourceConstraints) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 11 || length > 11 {
+		return fmt.Errorf("resourceConstraints/@REF: %q: length not in [11, 11]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_MDLegalConstraints struct {
 	TID              string           `xml:"TID,attr"`
 	UseConstraints   UseConstraints   `xml:"useConstraints"`
 	OtherConstraints OtherConstraints `xml:"otherConstraints"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_MDLegalConstraints) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 11 || length > 11 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_LegalConstraints/@TID: %q: length not in [11, 11]", v.TID)
+	}
+	if err := v.OtherConstraints.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_LegalConstraints/%w", err)
+	}
+	if err := v.UseConstraints.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_LegalConstraints/%w", err)
+	}
+	return nil
+}
+
 type UseConstraints struct {
 	GM03_2_1Comprehensive_Comprehensive_MDRestrictionCode_ GM03_2_1Comprehensive_Comprehensive_MDRestrictionCode_ `xml:"GM03_2_1Comprehensive.Comprehensive.MD_RestrictionCode_"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *UseConstraints) Validate() error {
+	if err := v.GM03_2_1Comprehensive_Comprehensive_MDRestrictionCode_.Validate(); err != nil {
+		return fmt.Errorf("useConstraints/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_MDRestrictionCode_ struct {
 	Value string `xml:"value"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_MDRestrictionCode_) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 2 || length > 23 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_RestrictionCode_/value: %q: length not in [2, 23]", v.Value)
+	}
+	return nil
+}
+
 type OtherConstraints struct {
 	GM03_2_1Core_Core_PTFreeText []GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *OtherConstraints) Validate() error {
+	if len(v.GM03_2_1Core_Core_PTFreeText) == 0 {
+		return errors.New("otherConstraints/GM03_2_1Core.Core.PT_FreeText: missing")
+	}
+	for _, v1 := range v.GM03_2_1Core_Core_PTFreeText {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("otherConstraints/%w", err)
+		}
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDResolution struct {
 	TID                  string               `xml:"TID,attr"`
 	MDDataIdentification MDDataIdentification `xml:"MD_DataIdentification"`
 	EquivalentScale      EquivalentScale      `xml:"equivalentScale"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDResolution) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 11 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Resolution/@TID: %q: length not in [11, 12]", v.TID)
+	}
+	if err := v.EquivalentScale.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Resolution/%w", err)
+	}
+	if err := v.MDDataIdentification.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_Resolution/%w", err)
+	}
+	return nil
+}
+
 type MDDataIdentification struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *MDDataIdentification) Validate() error {
+	switch v.REF {
+	case "xN1949928464":
+	default:
+		return fmt.Errorf("MD_DataIdentification/@REF: %q: invalid value", v.REF)
+	}
+	return nil
+}
+
 type EquivalentScale struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *EquivalentScale) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 10 || length > 12 {
+		return fmt.Errorf("equivalentScale/@REF: %q: length not in [10, 12]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_MDRepresentativeFraction struct {
 	TID         string `xml:"TID,attr"`
 	Denominator int    `xml:"denominator"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_MDRepresentativeFraction) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 10 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_RepresentativeFraction/@TID: %q: length not in [10, 12]", v.TID)
+	}
+	if v.Denominator < 25000 || v.Denominator > 1000000 {
+		return fmt.Errorf("GM03_2_1Core.Core.MD_RepresentativeFraction/denominator: %d: out of range [25000, 1000000]", v.Denominator)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_EXExtent struct {
 	TID                  string               `xml:"TID,attr"`
 	Description          Description          `xml:"description"`
 	MDDataIdentification MDDataIdentification `xml:"MD_DataIdentification"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_EXExtent) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_Extent/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if err := v.Description.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_Extent/%w", err)
+	}
+	if err := v.MDDataIdentification.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_Extent/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_EXExtentgeographicElement struct {
 	EXExtent          EXExtent          `xml:"EX_Extent"`
 	GeographicElement GeographicElement `xml:"geographicElement"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_EXExtentgeographicElement) Validate() error {
+	if err := v.EXExtent.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_ExtentgeographicElement/%w", err)
+	}
+	if err := v.GeographicElement.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_ExtentgeographicElement/%w", err)
+	}
+	return nil
+}
+
 type EXExtent struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *EXExtent) Validate() error {
+	switch v.REF {
+	case "xN1182675166":
+	default:
+		return fmt.Errorf("EX_Extent/@REF: %q: invalid value", v.REF)
+	}
+	return nil
+}
+
 type GeographicElement struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GeographicElement) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 11 || length > 12 {
+		return fmt.Errorf("geographicElement/@REF: %q: length not in [11, 12]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_EXGeographicBoundingBox struct {
 	TID                string  `xml:"TID,attr"`
 	NorthBoundLatitude float64 `xml:"northBoundLatitude"`
@@ -516,67 +1891,239 @@ type GM03_2_1Core_Core_EXGeographicBoundingBox struct {
 	WestBoundLongitude float64 `xml:"westBoundLongitude"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_EXGeographicBoundingBox) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_GeographicBoundingBox/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if v.EastBoundLongitude < 10.492036 || v.EastBoundLongitude > 10.492036 {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_GeographicBoundingBox/eastBoundLongitude: %g: out of range [10.492036, 10.492036]", v.EastBoundLongitude)
+	}
+	if v.NorthBoundLatitude < 47.808488 || v.NorthBoundLatitude > 47.808488 {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_GeographicBoundingBox/northBoundLatitude: %g: out of range [47.808488, 47.808488]", v.NorthBoundLatitude)
+	}
+	if v.SouthBoundLatitude < 45.81797 || v.SouthBoundLatitude > 45.81797 {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_GeographicBoundingBox/southBoundLatitude: %g: out of range [45.81797, 45.81797]", v.SouthBoundLatitude)
+	}
+	if v.WestBoundLongitude < 5.956088 || v.WestBoundLongitude > 5.956088 {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_GeographicBoundingBox/westBoundLongitude: %g: out of range [5.956088, 5.956088]", v.WestBoundLongitude)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_EXBoundingPolygon struct {
 	TID     string  `xml:"TID,attr"`
 	Polygon Polygon `xml:"polygon"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_EXBoundingPolygon) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 11 || length > 11 {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_BoundingPolygon/@TID: %q: length not in [11, 11]", v.TID)
+	}
+	if err := v.Polygon.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.EX_BoundingPolygon/%w", err)
+	}
+	return nil
+}
+
 type Polygon struct {
 	Surface Surface `xml:"SURFACE"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Polygon) Validate() error {
+	if err := v.Surface.Validate(); err != nil {
+		return fmt.Errorf("polygon/%w", err)
+	}
+	return nil
+}
+
 type Surface struct {
 	Boundary Boundary `xml:"BOUNDARY"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Surface) Validate() error {
+	if err := v.Boundary.Validate(); err != nil {
+		return fmt.Errorf("SURFACE/%w", err)
+	}
+	return nil
+}
+
 type Boundary struct {
 	PolyLine PolyLine `xml:"POLYLINE"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Boundary) Validate() error {
+	if err := v.PolyLine.Validate(); err != nil {
+		return fmt.Errorf("BOUNDARY/%w", err)
+	}
+	return nil
+}
+
 type PolyLine struct {
 	Coord []Coord `xml:"COORD"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *PolyLine) Validate() error {
+	if len(v.Coord) == 0 {
+		return errors.New("POLYLINE/COORD: missing")
+	}
+	for _, v1 := range v.Coord {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("POLYLINE/%w", err)
+		}
+	}
+	return nil
+}
+
 type Coord struct {
 	C1 float64 `xml:"C1"`
 	C2 float64 `xml:"C2"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Coord) Validate() error {
+	if v.C1 < 5.9560876 || v.C1 > 10.4920355 {
+		return fmt.Errorf("COORD/C1: %g: out of range [5.9560876, 10.4920355]", v.C1)
+	}
+	if v.C2 < 45.81797 || v.C2 > 47.808488 {
+		return fmt.Errorf("COORD/C2: %g: out of range [45.81797, 47.808488]", v.C2)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_DQDataQuality struct {
 	Gml320     string     `xml:"gml320,attr"`
 	TID        string     `xml:"TID,attr"`
 	MDMetadata MDMetadata `xml:"MD_Metadata"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_DQDataQuality) Validate() error {
+	if length := utf8.RuneCountInString(v.Gml320); length < 26 || length > 26 {
+		return fmt.Errorf("GM03_2_1Core.Core.DQ_DataQuality/@gml320: %q: length not in [26, 26]", v.Gml320)
+	}
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.DQ_DataQuality/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if err := v.MDMetadata.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.DQ_DataQuality/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_DQScope struct {
 	TID           string        `xml:"TID,attr"`
 	Level         string        `xml:"level"`
 	DQDataQuality DQDataQuality `xml:"DQ_DataQuality"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_DQScope) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 12 || length > 12 {
+		return fmt.Errorf("GM03_2_1Core.Core.DQ_Scope/@TID: %q: length not in [12, 12]", v.TID)
+	}
+	if err := v.DQDataQuality.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.DQ_Scope/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.Level); length < 7 || length > 7 {
+		return fmt.Errorf("GM03_2_1Core.Core.DQ_Scope/level: %q: length not in [7, 7]", v.Level)
+	}
+	return nil
+}
+
 type DQDataQuality struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *DQDataQuality) Validate() error {
+	switch v.REF {
+	case "xN1699364912":
+	default:
+		return fmt.Errorf("DQ_DataQuality/@REF: %q: invalid value", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_LILineage struct {
 	TID           string        `xml:"TID,attr"`
 	Statement     Statement     `xml:"statement"`
 	DQDataQuality DQDataQuality `xml:"DQ_DataQuality"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_LILineage) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 11 || length > 11 {
+		return fmt.Errorf("GM03_2_1Core.Core.LI_Lineage/@TID: %q: length not in [11, 11]", v.TID)
+	}
+	if err := v.DQDataQuality.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.LI_Lineage/%w", err)
+	}
+	if err := v.Statement.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Core.Core.LI_Lineage/%w", err)
+	}
+	return nil
+}
+
 type Statement struct {
 	GM03_2_1Core_Core_PTFreeText GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Statement) Validate() error {
+	if err := v.GM03_2_1Core_Core_PTFreeText.Validate(); err != nil {
+		return fmt.Errorf("statement/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_MDMetadatalegislationInformation struct {
 	MDMetadata             MDMetadata             `xml:"MD_Metadata"`
 	LegislationInformation LegislationInformation `xml:"legislationInformation"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_MDMetadatalegislationInformation) Validate() error {
+	if err := v.LegislationInformation.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_MetadatalegislationInformation/%w", err)
+	}
+	if err := v.MDMetadata.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_MetadatalegislationInformation/%w", err)
+	}
+	return nil
+}
+
 type LegislationInformation struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *LegislationInformation) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 16 || length > 16 {
+		return fmt.Errorf("legislationInformation/@REF: %q: length not in [16, 16]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_MDLegislation struct {
 	TID               string            `xml:"TID,attr"`
 	Country           Country           `xml:"country"`
@@ -586,27 +2133,110 @@ type GM03_2_1Comprehensive_Comprehensive_MDLegislation struct {
 	Title             Title             `xml:"title"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_MDLegislation) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 16 || length > 16 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_Legislation/@TID: %q: length not in [16, 16]", v.TID)
+	}
+	if err := v.Country.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_Legislation/%w", err)
+	}
+	if err := v.InternalReference.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_Legislation/%w", err)
+	}
+	if err := v.Language.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_Legislation/%w", err)
+	}
+	switch v.LegislationType {
+	case "nationalDecree":
+	default:
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_Legislation/legislationType: %q: invalid value", v.LegislationType)
+	}
+	if err := v.Title.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.MD_Legislation/%w", err)
+	}
+	return nil
+}
+
 type CodeISO_CountryCodeISO_ struct {
 	Value string `xml:"value"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *CodeISO_CountryCodeISO_) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 2 || length > 23 {
+		return fmt.Errorf("CodeISO.CountryCodeISO_/value: %q: length not in [2, 23]", v.Value)
+	}
+	return nil
+}
+
 type InternalReference struct {
 	GM03_2_1Core_Core_CharacterString_ GM03_2_1Core_Core_CharacterString_ `xml:"GM03_2_1Core.Core.CharacterString_"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *InternalReference) Validate() error {
+	if err := v.GM03_2_1Core_Core_CharacterString_.Validate(); err != nil {
+		return fmt.Errorf("internalReference/%w", err)
+	}
+	return nil
+}
+
 type GM03_2_1Core_Core_CharacterString_ struct {
 	Value string `xml:"value"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Core_Core_CharacterString_) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 2 || length > 23 {
+		return fmt.Errorf("GM03_2_1Core.Core.CharacterString_/value: %q: length not in [2, 23]", v.Value)
+	}
+	return nil
+}
+
 type OtherCitationDetails struct {
 	GM03_2_1Core_Core_PTFreeText GM03_2_1Core_Core_PTFreeText `xml:"GM03_2_1Core.Core.PT_FreeText"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *OtherCitationDetails) Validate() error {
+	if err := v.GM03_2_1Core_Core_PTFreeText.Validate(); err != nil {
+		return fmt.Errorf("otherCitationDetails/%w", err)
+	}
+	return nil
+}
+
 type Series struct {
 	REF string `xml:"REF,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Series) Validate() error {
+	if length := utf8.RuneCountInString(v.REF); length < 11 || length > 11 {
+		return fmt.Errorf("series/@REF: %q: length not in [11, 11]", v.REF)
+	}
+	return nil
+}
+
 type GM03_2_1Comprehensive_Comprehensive_CISeries struct {
 	TID  string `xml:"TID,attr"`
 	Name Name   `xml:"name"`
 }
+
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *GM03_2_1Comprehensive_Comprehensive_CISeries) Validate() error {
+	if length := utf8.RuneCountInString(v.TID); length < 11 || length > 11 {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.CI_Series/@TID: %q: length not in [11, 11]", v.TID)
+	}
+	if err := v.Name.Validate(); err != nil {
+		return fmt.Errorf("GM03_2_1Comprehensive.Comprehensive.CI_Series/%w", err)
+	}
+	return nil
+}
@@ -30,6 +30,7 @@ func TestInterlis(t *testing.T) {
 		xmlstruct.WithNamedTypes(true),
 		xmlstruct.WithPackageName("interlis"),
 		xmlstruct.WithPreserveOrder(true),
+		xmlstruct.WithValidateMethods(true),
 	)
 
 	assert.NoError(t, generator.ObserveFile("testdata/metadata_gm03.xml"))
@@ -47,6 +48,7 @@ func TestInterlis(t *testing.T) {
 	assert.NoError(t, err)
 	var transfer interlis.Transfer
 	assert.NoError(t, xml.Unmarshal(data, &transfer))
+	assert.NoError(t, transfer.Validate())
 
 	assert.Equal(t, "geocat.ch", transfer.HeaderSection.Sender)
 }
@@ -217,9 +217,9 @@ type Fermata struct {
 }
 
 type Forward struct {
-	Duration int `xml:"duration"`
-	Staff    int `xml:"staff"`
-	Voice    int `xml:"voice"`
+	Duration int  `xml:"duration"`
+	Staff    *int `xml:"staff"`
+	Voice    int  `xml:"voice"`
 }
 
 type Glyph struct {
@@ -322,13 +322,13 @@ type MeasureNumbering struct {
 }
 
 type Metronome struct {
-	DefaultY    int      `xml:"default-y,attr"`
-	FontFamily  string   `xml:"font-family,attr"`
-	FontSize    float64  `xml:"font-size,attr"`
-	Parentheses string   `xml:"parentheses,attr"`
-	BeatUnit    string   `xml:"beat-unit"`
-	BeatUnitDot struct{} `xml:"beat-unit-dot"`
-	PerMinute   int      `xml:"per-minute"`
+	DefaultY    *int      `xml:"default-y,attr"`
+	FontFamily  string    `xml:"font-family,attr"`
+	FontSize    float64   `xml:"font-size,attr"`
+	Parentheses string    `xml:"parentheses,attr"`
+	BeatUnit    string    `xml:"beat-unit"`
+	BeatUnitDot *struct{} `xml:"beat-unit-dot"`
+	PerMinute   int       `xml:"per-minute"`
 }
 
 type MidiInstrument struct {
@@ -407,8 +407,8 @@ type Offset struct {
 }
 
 type Ornaments struct {
-	Tremolo   *Tremolo  `xml:"tremolo"`
-	TrillMark TrillMark `xml:"trill-mark"`
+	Tremolo   *Tremolo   `xml:"tremolo"`
+	TrillMark *TrillMark `xml:"trill-mark"`
 }
 
 type OtherDirection struct {
@@ -523,7 +523,7 @@ type RightDivider struct {
 }
 
 type Root struct {
-	RootAlter int    `xml:"root-alter"`
+	RootAlter *int   `xml:"root-alter"`
 	RootStep  string `xml:"root-step"`
 }
 
@@ -664,9 +664,9 @@ type SystemMargins struct {
 
 type Technical struct {
 	DownBow *DownBow `xml:"down-bow"`
-	Fret    int      `xml:"fret"`
+	Fret    *int     `xml:"fret"`
 	PullOff *PullOff `xml:"pull-off"`
-	String  int      `xml:"string"`
+	String  *int     `xml:"string"`
 	UpBow   *UpBow   `xml:"up-bow"`
 }
 
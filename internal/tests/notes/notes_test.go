@@ -0,0 +1,43 @@
+package notes_test
+
+import (
+	"encoding/xml"
+	"os"
+	"testing"
+
+	"github.com/alecthomas/assert/v2"
+
+	"github.com/twpayne/go-xmlstruct"
+	"github.com/twpayne/go-xmlstruct/internal/tests/notes"
+)
+
+func TestNotes(t *testing.T) {
+	t.Parallel()
+
+	generator := xmlstruct.NewGenerator(
+		xmlstruct.WithNamedTypes(true),
+		xmlstruct.WithPackageName("notes"),
+		xmlstruct.WithPreserveOrder(true),
+		xmlstruct.WithValidateMethods(true),
+	)
+
+	assert.NoError(t, generator.ObserveFile("testdata/notes.xml"))
+
+	actualSource, err := generator.Generate()
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.WriteFile("notes.gen.go.actual", actualSource, 0o666))
+
+	expectedSource, err := os.ReadFile("notes.gen.go")
+	assert.NoError(t, err)
+	assert.Equal(t, string(expectedSource), string(actualSource))
+
+	data, err := os.ReadFile("testdata/notes.xml")
+	assert.NoError(t, err)
+	var notes notes.Notes
+	assert.NoError(t, xml.Unmarshal(data, &notes))
+	assert.NoError(t, notes.Validate())
+
+	assert.Equal(t, 4, len(notes.Note))
+	assert.Equal(t, "Shopping", notes.Note[0].Title)
+}
@@ -2,7 +2,12 @@
 
 package play
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
 
 type Play struct {
 	XMLName          xml.Name    `xml:"PLAY"`
@@ -14,25 +19,132 @@ type Play struct {
 	Act              []Act       `xml:"ACT"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Play) Validate() error {
+	if len(v.Act) == 0 {
+		return errors.New("PLAY/ACT: missing")
+	}
+	for _, v1 := range v.Act {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("PLAY/%w", err)
+		}
+	}
+	if err := v.FrontMatter.Validate(); err != nil {
+		return fmt.Errorf("PLAY/%w", err)
+	}
+	if err := v.Personae.Validate(); err != nil {
+		return fmt.Errorf("PLAY/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.PlaySubtitle); length < 25 || length > 25 {
+		return fmt.Errorf("PLAY/PLAYSUBT: %q: length not in [25, 25]", v.PlaySubtitle)
+	}
+	if length := utf8.RuneCountInString(v.SceneDescription); length < 46 || length > 46 {
+		return fmt.Errorf("PLAY/SCNDESCR: %q: length not in [46, 46]", v.SceneDescription)
+	}
+	if length := utf8.RuneCountInString(v.Title); length < 5 || length > 57 {
+		return fmt.Errorf("PLAY/TITLE: %q: length not in [5, 57]", v.Title)
+	}
+	return nil
+}
+
 type FrontMatter struct {
 	Paragraph []string `xml:"P"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *FrontMatter) Validate() error {
+	if len(v.Paragraph) == 0 {
+		return errors.New("FM/P: missing")
+	}
+	for _, v1 := range v.Paragraph {
+		if length := utf8.RuneCountInString(v1); length < 36 || length > 61 {
+			return fmt.Errorf("FM/P: %q: length not in [36, 61]", v1)
+		}
+	}
+	return nil
+}
+
 type Personae struct {
 	Title        string         `xml:"TITLE"`
 	Persona      []string       `xml:"PERSONA"`
 	PersonaGroup []PersonaGroup `xml:"PGROUP"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Personae) Validate() error {
+	if len(v.Persona) == 0 {
+		return errors.New("PERSONAE/PERSONA: missing")
+	}
+	for _, v1 := range v.Persona {
+		if length := utf8.RuneCountInString(v1); length < 5 || length > 54 {
+			return fmt.Errorf("PERSONAE/PERSONA: %q: length not in [5, 54]", v1)
+		}
+	}
+	if len(v.PersonaGroup) == 0 {
+		return errors.New("PERSONAE/PGROUP: missing")
+	}
+	for _, v1 := range v.PersonaGroup {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("PERSONAE/%w", err)
+		}
+	}
+	if length := utf8.RuneCountInString(v.Title); length < 5 || length > 57 {
+		return fmt.Errorf("PERSONAE/TITLE: %q: length not in [5, 57]", v.Title)
+	}
+	return nil
+}
+
 type PersonaGroup struct {
 	Persona          []string `xml:"PERSONA"`
 	GroupDescription string   `xml:"GRPDESCR"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *PersonaGroup) Validate() error {
+	if length := utf8.RuneCountInString(v.GroupDescription); length < 36 || length > 38 {
+		return fmt.Errorf("PGROUP/GRPDESCR: %q: length not in [36, 38]", v.GroupDescription)
+	}
+	if len(v.Persona) == 0 {
+		return errors.New("PGROUP/PERSONA: missing")
+	}
+	for _, v1 := range v.Persona {
+		if length := utf8.RuneCountInString(v1); length < 5 || length > 54 {
+			return fmt.Errorf("PGROUP/PERSONA: %q: length not in [5, 54]", v1)
+		}
+	}
+	return nil
+}
+
 type Act struct {
-	Title    string   `xml:"TITLE"`
-	Scene    []Scene  `xml:"SCENE"`
-	Epilogue Epilogue `xml:"EPILOGUE"`
+	Title    string    `xml:"TITLE"`
+	Scene    []Scene   `xml:"SCENE"`
+	Epilogue *Epilogue `xml:"EPILOGUE"`
+}
+
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Act) Validate() error {
+	if v.Epilogue != nil {
+		if err := v.Epilogue.Validate(); err != nil {
+			return fmt.Errorf("ACT/%w", err)
+		}
+	}
+	if len(v.Scene) == 0 {
+		return errors.New("ACT/SCENE: missing")
+	}
+	for _, v1 := range v.Scene {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("ACT/%w", err)
+		}
+	}
+	if length := utf8.RuneCountInString(v.Title); length < 5 || length > 57 {
+		return fmt.Errorf("ACT/TITLE: %q: length not in [5, 57]", v.Title)
+	}
+	return nil
 }
 
 type Scene struct {
@@ -41,19 +153,95 @@ type Scene struct {
 	Speech         []Speech `xml:"SPEECH"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Scene) Validate() error {
+	if len(v.Speech) == 0 {
+		return errors.New("SCENE/SPEECH: missing")
+	}
+	for _, v1 := range v.Speech {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("SCENE/%w", err)
+		}
+	}
+	if len(v.StageDirection) == 0 {
+		return errors.New("SCENE/STAGEDIR: missing")
+	}
+	for _, v1 := range v.StageDirection {
+		if length := utf8.RuneCountInString(v1); length < 4 || length > 128 {
+			return fmt.Errorf("SCENE/STAGEDIR: %q: length not in [4, 128]", v1)
+		}
+	}
+	if length := utf8.RuneCountInString(v.Title); length < 5 || length > 57 {
+		return fmt.Errorf("SCENE/TITLE: %q: length not in [5, 57]", v.Title)
+	}
+	return nil
+}
+
 type Speech struct {
 	Speaker        string   `xml:"SPEAKER"`
 	Line           []Line   `xml:"LINE"`
 	StageDirection []string `xml:"STAGEDIR"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Speech) Validate() error {
+	if len(v.Line) == 0 {
+		return errors.New("SPEECH/LINE: missing")
+	}
+	for _, v1 := range v.Line {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("SPEECH/%w", err)
+		}
+	}
+	if length := utf8.RuneCountInString(v.Speaker); length < 3 || length > 16 {
+		return fmt.Errorf("SPEECH/SPEAKER: %q: length not in [3, 16]", v.Speaker)
+	}
+	for _, v1 := range v.StageDirection {
+		if length := utf8.RuneCountInString(v1); length < 4 || length > 128 {
+			return fmt.Errorf("SPEECH/STAGEDIR: %q: length not in [4, 128]", v1)
+		}
+	}
+	return nil
+}
+
 type Line struct {
 	CharData       string  `xml:",chardata"`
 	StageDirection *string `xml:"STAGEDIR"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Line) Validate() error {
+	if length := utf8.RuneCountInString(v.CharData); length < 0 || length > 62 {
+		return fmt.Errorf("LINE: %q: length not in [0, 62]", v.CharData)
+	}
+	if v.StageDirection != nil {
+		if length := utf8.RuneCountInString(*v.StageDirection); length < 4 || length > 128 {
+			return fmt.Errorf("LINE/STAGEDIR: %q: length not in [4, 128]", *v.StageDirection)
+		}
+	}
+	return nil
+}
+
 type Epilogue struct {
 	Title          string `xml:"TITLE"`
 	Speech         Speech `xml:"SPEECH"`
 	StageDirection string `xml:"STAGEDIR"`
 }
+
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Epilogue) Validate() error {
+	if err := v.Speech.Validate(); err != nil {
+		return fmt.Errorf("EPILOGUE/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.StageDirection); length < 4 || length > 128 {
+		return fmt.Errorf("EPILOGUE/STAGEDIR: %q: length not in [4, 128]", v.StageDirection)
+	}
+	if length := utf8.RuneCountInString(v.Title); length < 5 || length > 57 {
+		return fmt.Errorf("EPILOGUE/TITLE: %q: length not in [5, 57]", v.Title)
+	}
+	return nil
+}
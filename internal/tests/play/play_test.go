@@ -39,6 +39,7 @@ func TestPlay(t *testing.T) {
 		xmlstruct.WithNamedTypes(true),
 		xmlstruct.WithPackageName("play"),
 		xmlstruct.WithPreserveOrder(true),
+		xmlstruct.WithValidateMethods(true),
 	)
 
 	assert.NoError(t, generator.ObserveFile("testdata/all_well.xml"))
@@ -56,6 +57,7 @@ func TestPlay(t *testing.T) {
 	assert.NoError(t, err)
 	var allsWellThatEndsWell play.Play
 	assert.NoError(t, xml.Unmarshal(data, &allsWellThatEndsWell))
+	assert.NoError(t, allsWellThatEndsWell.Validate())
 
 	assert.Equal(t, 5, len(allsWellThatEndsWell.Act))
 	assert.Equal(t, "All's well that ends well; still the fine's the crown;", allsWellThatEndsWell.Act[3].Scene[3].Speech[4].Line[5].CharData)
@@ -2,10 +2,25 @@
 
 package rss
 
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
 type RSS struct {
 	Channel Channel `xml:"channel"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *RSS) Validate() error {
+	if err := v.Channel.Validate(); err != nil {
+		return fmt.Errorf("rss/%w", err)
+	}
+	return nil
+}
+
 type Channel struct {
 	Title          string `xml:"title"`
 	Link           []Link `xml:"link"`
@@ -20,6 +35,55 @@ type Channel struct {
 	Item           []Item `xml:"item"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Channel) Validate() error {
+	if length := utf8.RuneCountInString(v.Description); length < 93 || length > 198 {
+		return fmt.Errorf("channel/description: %q: length not in [93, 198]", v.Description)
+	}
+	if length := utf8.RuneCountInString(v.Docs); length < 42 || length > 42 {
+		return fmt.Errorf("channel/docs: %q: length not in [42, 42]", v.Docs)
+	}
+	if length := utf8.RuneCountInString(v.Generator); length < 13 || length > 13 {
+		return fmt.Errorf("channel/generator: %q: length not in [13, 13]", v.Generator)
+	}
+	if len(v.Item) == 0 {
+		return errors.New("channel/item: missing")
+	}
+	for _, v1 := range v.Item {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("channel/%w", err)
+		}
+	}
+	if length := utf8.RuneCountInString(v.Language); length < 5 || length > 5 {
+		return fmt.Errorf("channel/language: %q: length not in [5, 5]", v.Language)
+	}
+	if length := utf8.RuneCountInString(v.LastBuildDate); length < 26 || length > 26 {
+		return fmt.Errorf("channel/lastBuildDate: %q: length not in [26, 26]", v.LastBuildDate)
+	}
+	if len(v.Link) == 0 {
+		return errors.New("channel/link: missing")
+	}
+	for _, v1 := range v.Link {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("channel/%w", err)
+		}
+	}
+	if length := utf8.RuneCountInString(v.ManagingEditor); length < 43 || length > 43 {
+		return fmt.Errorf("channel/managingEditor: %q: length not in [43, 43]", v.ManagingEditor)
+	}
+	if length := utf8.RuneCountInString(v.PubDate); length < 26 || length > 29 {
+		return fmt.Errorf("channel/pubDate: %q: length not in [26, 29]", v.PubDate)
+	}
+	if length := utf8.RuneCountInString(v.Title); length < 23 || length > 68 {
+		return fmt.Errorf("channel/title: %q: length not in [23, 68]", v.Title)
+	}
+	if length := utf8.RuneCountInString(v.WebMaster); length < 35 || length > 35 {
+		return fmt.Errorf("channel/webMaster: %q: length not in [35, 35]", v.WebMaster)
+	}
+	return nil
+}
+
 type Link struct {
 	Href     *string `xml:"href,attr"`
 	Rel      *string `xml:"rel,attr"`
@@ -27,6 +91,30 @@ type Link struct {
 	CharData string  `xml:",chardata"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Link) Validate() error {
+	if v.Href != nil {
+		if length := utf8.RuneCountInString(*v.Href); length < 0 || length > 47 {
+			return fmt.Errorf("link/@href: %q: length not in [0, 47]", *v.Href)
+		}
+	}
+	if v.Rel != nil {
+		if length := utf8.RuneCountInString(*v.Rel); length < 0 || length > 4 {
+			return fmt.Errorf("link/@rel: %q: length not in [0, 4]", *v.Rel)
+		}
+	}
+	if v.Type != nil {
+		if length := utf8.RuneCountInString(*v.Type); length < 0 || length > 19 {
+			return fmt.Errorf("link/@type: %q: length not in [0, 19]", *v.Type)
+		}
+	}
+	if length := utf8.RuneCountInString(v.CharData); length < 0 || length > 102 {
+		return fmt.Errorf("link: %q: length not in [0, 102]", v.CharData)
+	}
+	return nil
+}
+
 type Item struct {
 	Title       *string    `xml:"title"`
 	Link        Link       `xml:"link"`
@@ -36,8 +124,53 @@ type Item struct {
 	Enclosure   *Enclosure `xml:"enclosure"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Item) Validate() error {
+	if length := utf8.RuneCountInString(v.Description); length < 93 || length > 198 {
+		return fmt.Errorf("item/description: %q: length not in [93, 198]", v.Description)
+	}
+	if v.Enclosure != nil {
+		if err := v.Enclosure.Validate(); err != nil {
+			return fmt.Errorf("item/%w", err)
+		}
+	}
+	if length := utf8.RuneCountInString(v.GUID); length < 52 || length > 102 {
+		return fmt.Errorf("item/guid: %q: length not in [52, 102]", v.GUID)
+	}
+	if err := v.Link.Validate(); err != nil {
+		return fmt.Errorf("item/%w", err)
+	}
+	if length := utf8.RuneCountInString(v.PubDate); length < 26 || length > 29 {
+		return fmt.Errorf("item/pubDate: %q: length not in [26, 29]", v.PubDate)
+	}
+	if v.Title != nil {
+		if length := utf8.RuneCountInString(*v.Title); length < 23 || length > 68 {
+			return fmt.Errorf("item/title: %q: length not in [23, 68]", *v.Title)
+		}
+	}
+	return nil
+}
+
 type Enclosure struct {
 	Length int    `xml:"length,attr"`
 	Type   string `xml:"type,attr"`
 	URL    string `xml:"url,attr"`
 }
+
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Enclosure) Validate() error {
+	if v.Length < 269866 || v.Length > 1032272 {
+		return fmt.Errorf("enclosure/@length: %d: out of range [269866, 1032272]", v.Length)
+	}
+	switch v.Type {
+	case "image/jpeg":
+	default:
+		return fmt.Errorf("enclosure/@type: %q: invalid value", v.Type)
+	}
+	if length := utf8.RuneCountInString(v.URL); length < 119 || length > 123 {
+		return fmt.Errorf("enclosure/@url: %q: length not in [119, 123]", v.URL)
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ func TestPlay(t *testing.T) {
 		xmlstruct.WithNamedTypes(true),
 		xmlstruct.WithPackageName("rss"),
 		xmlstruct.WithPreserveOrder(true),
+		xmlstruct.WithValidateMethods(true),
 	)
 
 	assert.NoError(t, generator.ObserveFile("testdata/sample-rss-2.xml"))
@@ -41,6 +42,7 @@ func TestPlay(t *testing.T) {
 
 	var nasaSpaceStationNews rss.RSS
 	assert.NoError(t, xml.Unmarshal(data, &nasaSpaceStationNews))
+	assert.NoError(t, nasaSpaceStationNews.Validate())
 
 	assert.Equal(t, 5, len(nasaSpaceStationNews.Channel.Item))
 	assert.Equal(t, "Louisiana Students to Hear from NASA Astronauts Aboard Space Station", *nasaSpaceStationNews.Channel.Item[0].Title)
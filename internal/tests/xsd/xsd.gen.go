@@ -2,11 +2,31 @@
 
 package xsd
 
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
 type Annotation struct {
 	AppInfo       *string `xml:"appinfo"`
 	Documentation string  `xml:"documentation"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Annotation) Validate() error {
+	if v.AppInfo != nil {
+		if length := utf8.RuneCountInString(*v.AppInfo); length < 23 || length > 23 {
+			return fmt.Errorf("annotation/appinfo: %q: length not in [23, 23]", *v.AppInfo)
+		}
+	}
+	if length := utf8.RuneCountInString(v.Documentation); length < 21 || length > 115 {
+		return fmt.Errorf("annotation/documentation: %q: length not in [21, 115]", v.Documentation)
+	}
+	return nil
+}
+
 type Any struct {
 	MaxOccurs       *string `xml:"maxOccurs,attr"`
 	MinOccurs       *int    `xml:"minOccurs,attr"`
@@ -14,11 +34,51 @@ type Any struct {
 	ProcessContents string  `xml:"processContents,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Any) Validate() error {
+	if v.MaxOccurs != nil {
+		switch *v.MaxOccurs {
+		case "", "unbounded":
+		default:
+			return fmt.Errorf("any/@maxOccurs: %q: invalid value", *v.MaxOccurs)
+		}
+	}
+	if v.MinOccurs != nil {
+		if *v.MinOccurs < 0 || *v.MinOccurs > 0 {
+			return fmt.Errorf("any/@minOccurs: %d: out of range [0, 0]", *v.MinOccurs)
+		}
+	}
+	switch v.Namespace {
+	case "##any", "##other":
+	default:
+		return fmt.Errorf("any/@namespace: %q: invalid value", v.Namespace)
+	}
+	switch v.ProcessContents {
+	case "lax":
+	default:
+		return fmt.Errorf("any/@processContents: %q: invalid value", v.ProcessContents)
+	}
+	return nil
+}
+
 type AnyAttribute struct {
 	Namespace       string `xml:"namespace,attr"`
 	ProcessContents string `xml:"processContents,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *AnyAttribute) Validate() error {
+	if length := utf8.RuneCountInString(v.Namespace); length < 5 || length > 5 {
+		return fmt.Errorf("anyAttribute/@namespace: %q: length not in [5, 5]", v.Namespace)
+	}
+	if length := utf8.RuneCountInString(v.ProcessContents); length < 3 || length > 3 {
+		return fmt.Errorf("anyAttribute/@processContents: %q: length not in [3, 3]", v.ProcessContents)
+	}
+	return nil
+}
+
 type Attribute struct {
 	Default *string `xml:"default,attr"`
 	Name    *string `xml:"name,attr"`
@@ -27,12 +87,66 @@ type Attribute struct {
 	Use     *string `xml:"use,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Attribute) Validate() error {
+	if v.Default != nil {
+		if length := utf8.RuneCountInString(*v.Default); length < 0 || length > 8 {
+			return fmt.Errorf("attribute/@default: %q: length not in [0, 8]", *v.Default)
+		}
+	}
+	if v.Name != nil {
+		if length := utf8.RuneCountInString(*v.Name); length < 0 || length > 20 {
+			return fmt.Errorf("attribute/@name: %q: length not in [0, 20]", *v.Name)
+		}
+	}
+	if v.Ref != nil {
+		if length := utf8.RuneCountInString(*v.Ref); length < 0 || length > 6 {
+			return fmt.Errorf("attribute/@ref: %q: length not in [0, 6]", *v.Ref)
+		}
+	}
+	if v.Type != nil {
+		if length := utf8.RuneCountInString(*v.Type); length < 0 || length > 22 {
+			return fmt.Errorf("attribute/@type: %q: length not in [0, 22]", *v.Type)
+		}
+	}
+	if v.Use != nil {
+		switch *v.Use {
+		case "", "optional", "required":
+		default:
+			return fmt.Errorf("attribute/@use: %q: invalid value", *v.Use)
+		}
+	}
+	return nil
+}
+
 type AttributeGroup struct {
-	Name      string      `xml:"name,attr"`
+	Name      *string     `xml:"name,attr"`
 	Ref       *string     `xml:"ref,attr"`
 	Attribute []Attribute `xml:"attribute"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *AttributeGroup) Validate() error {
+	if v.Name != nil {
+		if length := utf8.RuneCountInString(*v.Name); length < 0 || length > 12 {
+			return fmt.Errorf("attributeGroup/@name: %q: length not in [0, 12]", *v.Name)
+		}
+	}
+	if v.Ref != nil {
+		if length := utf8.RuneCountInString(*v.Ref); length < 0 || length > 16 {
+			return fmt.Errorf("attributeGroup/@ref: %q: length not in [0, 16]", *v.Ref)
+		}
+	}
+	for _, v1 := range v.Attribute {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("attributeGroup/%w", err)
+		}
+	}
+	return nil
+}
+
 type Choice struct {
 	MaxOccurs  *string     `xml:"maxOccurs,attr"`
 	MinOccurs  *int        `xml:"minOccurs,attr"`
@@ -40,11 +154,51 @@ type Choice struct {
 	Element    []Element   `xml:"element"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Choice) Validate() error {
+	if v.MaxOccurs != nil {
+		switch *v.MaxOccurs {
+		case "", "unbounded":
+		default:
+			return fmt.Errorf("choice/@maxOccurs: %q: invalid value", *v.MaxOccurs)
+		}
+	}
+	if v.MinOccurs != nil {
+		if *v.MinOccurs < 0 || *v.MinOccurs > 0 {
+			return fmt.Errorf("choice/@minOccurs: %d: out of range [0, 0]", *v.MinOccurs)
+		}
+	}
+	if v.Annotation != nil {
+		if err := v.Annotation.Validate(); err != nil {
+			return fmt.Errorf("choice/%w", err)
+		}
+	}
+	if len(v.Element) == 0 {
+		return errors.New("choice/element: missing")
+	}
+	for _, v1 := range v.Element {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("choice/%w", err)
+		}
+	}
+	return nil
+}
+
 type ComplexContent struct {
 	Mixed     *bool     `xml:"mixed,attr"`
 	Extension Extension `xml:"extension"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *ComplexContent) Validate() error {
+	if err := v.Extension.Validate(); err != nil {
+		return fmt.Errorf("complexContent/%w", err)
+	}
+	return nil
+}
+
 type ComplexType struct {
 	Abstract       *bool           `xml:"abstract,attr"`
 	Final          *string         `xml:"final,attr"`
@@ -59,6 +213,57 @@ type ComplexType struct {
 	SimpleContent  *SimpleContent  `xml:"simpleContent"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *ComplexType) Validate() error {
+	if v.Final != nil {
+		switch *v.Final {
+		case "", "#all":
+		default:
+			return fmt.Errorf("complexType/@final: %q: invalid value", *v.Final)
+		}
+	}
+	if length := utf8.RuneCountInString(v.Name); length < 7 || length > 33 {
+		return fmt.Errorf("complexType/@name: %q: length not in [7, 33]", v.Name)
+	}
+	if v.Annotation != nil {
+		if err := v.Annotation.Validate(); err != nil {
+			return fmt.Errorf("complexType/%w", err)
+		}
+	}
+	for _, v1 := range v.Attribute {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("complexType/%w", err)
+		}
+	}
+	if v.AttributeGroup != nil {
+		if err := v.AttributeGroup.Validate(); err != nil {
+			return fmt.Errorf("complexType/%w", err)
+		}
+	}
+	if v.Choice != nil {
+		if err := v.Choice.Validate(); err != nil {
+			return fmt.Errorf("complexType/%w", err)
+		}
+	}
+	if v.ComplexContent != nil {
+		if err := v.ComplexContent.Validate(); err != nil {
+			return fmt.Errorf("complexType/%w", err)
+		}
+	}
+	if v.Sequence != nil {
+		if err := v.Sequence.Validate(); err != nil {
+			return fmt.Errorf("complexType/%w", err)
+		}
+	}
+	if v.SimpleContent != nil {
+		if err := v.SimpleContent.Validate(); err != nil {
+			return fmt.Errorf("complexType/%w", err)
+		}
+	}
+	return nil
+}
+
 type Element struct {
 	Abstract          *bool       `xml:"abstract,attr"`
 	Default           *string     `xml:"default,attr"`
@@ -71,10 +276,67 @@ type Element struct {
 	Annotation        *Annotation `xml:"annotation"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Element) Validate() error {
+	if v.Default != nil {
+		if length := utf8.RuneCountInString(*v.Default); length < 0 || length > 13 {
+			return fmt.Errorf("element/@default: %q: length not in [0, 13]", *v.Default)
+		}
+	}
+	if v.MaxOccurs != nil {
+		switch *v.MaxOccurs {
+		case "", "unbounded":
+		default:
+			return fmt.Errorf("element/@maxOccurs: %q: invalid value", *v.MaxOccurs)
+		}
+	}
+	if v.MinOccurs != nil {
+		if *v.MinOccurs < 0 || *v.MinOccurs > 0 {
+			return fmt.Errorf("element/@minOccurs: %d: out of range [0, 0]", *v.MinOccurs)
+		}
+	}
+	if v.Name != nil {
+		if length := utf8.RuneCountInString(*v.Name); length < 0 || length > 41 {
+			return fmt.Errorf("element/@name: %q: length not in [0, 41]", *v.Name)
+		}
+	}
+	if v.Ref != nil {
+		if length := utf8.RuneCountInString(*v.Ref); length < 0 || length > 45 {
+			return fmt.Errorf("element/@ref: %q: length not in [0, 45]", *v.Ref)
+		}
+	}
+	if v.SubstitutionGroup != nil {
+		if length := utf8.RuneCountInString(*v.SubstitutionGroup); length < 0 || length > 42 {
+			return fmt.Errorf("element/@substitutionGroup: %q: length not in [0, 42]", *v.SubstitutionGroup)
+		}
+	}
+	if v.Type != nil {
+		if length := utf8.RuneCountInString(*v.Type); length < 0 || length > 39 {
+			return fmt.Errorf("element/@type: %q: length not in [0, 39]", *v.Type)
+		}
+	}
+	if v.Annotation != nil {
+		if err := v.Annotation.Validate(); err != nil {
+			return fmt.Errorf("element/%w", err)
+		}
+	}
+	return nil
+}
+
 type Enumeration struct {
 	Value string `xml:"value,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Enumeration) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 4 || length > 18 {
+		return fmt.Errorf("enumeration/@value: %q: length not in [4, 18]", v.Value)
+	}
+	return nil
+}
+
 type Extension struct {
 	Base         string        `xml:"base,attr"`
 	AnyAttribute *AnyAttribute `xml:"anyAttribute"`
@@ -82,38 +344,155 @@ type Extension struct {
 	Sequence     *Sequence     `xml:"sequence"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Extension) Validate() error {
+	if length := utf8.RuneCountInString(v.Base); length < 6 || length > 29 {
+		return fmt.Errorf("extension/@base: %q: length not in [6, 29]", v.Base)
+	}
+	if v.AnyAttribute != nil {
+		if err := v.AnyAttribute.Validate(); err != nil {
+			return fmt.Errorf("extension/%w", err)
+		}
+	}
+	for _, v1 := range v.Attribute {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("extension/%w", err)
+		}
+	}
+	if v.Sequence != nil {
+		if err := v.Sequence.Validate(); err != nil {
+			return fmt.Errorf("extension/%w", err)
+		}
+	}
+	return nil
+}
+
 type Import struct {
 	Namespace      string `xml:"namespace,attr"`
 	SchemaLocation string `xml:"schemaLocation,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Import) Validate() error {
+	if length := utf8.RuneCountInString(v.Namespace); length < 27 || length > 40 {
+		return fmt.Errorf("import/@namespace: %q: length not in [27, 40]", v.Namespace)
+	}
+	if length := utf8.RuneCountInString(v.SchemaLocation); length < 20 || length > 52 {
+		return fmt.Errorf("import/@schemaLocation: %q: length not in [20, 52]", v.SchemaLocation)
+	}
+	return nil
+}
+
 type Length struct {
 	Value int `xml:"value,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Length) Validate() error {
+	if v.Value < 4 || v.Value > 4 {
+		return fmt.Errorf("length/@value: %d: out of range [4, 4]", v.Value)
+	}
+	return nil
+}
+
 type List struct {
 	ItemType string `xml:"itemType,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *List) Validate() error {
+	if length := utf8.RuneCountInString(v.ItemType); length < 6 || length > 25 {
+		return fmt.Errorf("list/@itemType: %q: length not in [6, 25]", v.ItemType)
+	}
+	return nil
+}
+
 type MaxInclusive struct {
 	Value float64 `xml:"value,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *MaxInclusive) Validate() error {
+	if v.Value < 1 || v.Value > 360 {
+		return fmt.Errorf("maxInclusive/@value: %g: out of range [1, 360]", v.Value)
+	}
+	return nil
+}
+
 type MinInclusive struct {
 	Value float64 `xml:"value,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *MinInclusive) Validate() error {
+	if v.Value < -360 || v.Value > 0 {
+		return fmt.Errorf("minInclusive/@value: %g: out of range [-360, 0]", v.Value)
+	}
+	return nil
+}
+
 type Pattern struct {
 	Value string `xml:"value,attr"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Pattern) Validate() error {
+	if length := utf8.RuneCountInString(v.Value); length < 11 || length > 24 {
+		return fmt.Errorf("pattern/@value: %q: length not in [11, 24]", v.Value)
+	}
+	return nil
+}
+
 type Restriction struct {
 	Base         string        `xml:"base,attr"`
 	Enumeration  []Enumeration `xml:"enumeration"`
 	Length       *Length       `xml:"length"`
 	MaxInclusive *MaxInclusive `xml:"maxInclusive"`
 	MinInclusive *MinInclusive `xml:"minInclusive"`
-	Pattern      Pattern       `xml:"pattern"`
+	Pattern      *Pattern      `xml:"pattern"`
+}
+
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Restriction) Validate() error {
+	switch v.Base {
+	case "double", "float", "hexBinary", "string", "xs:string":
+	default:
+		return fmt.Errorf("restriction/@base: %q: invalid value", v.Base)
+	}
+	for _, v1 := range v.Enumeration {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("restriction/%w", err)
+		}
+	}
+	if v.Length != nil {
+		if err := v.Length.Validate(); err != nil {
+			return fmt.Errorf("restriction/%w", err)
+		}
+	}
+	if v.MaxInclusive != nil {
+		if err := v.MaxInclusive.Validate(); err != nil {
+			return fmt.Errorf("restriction/%w", err)
+		}
+	}
+	if v.MinInclusive != nil {
+		if err := v.MinInclusive.Validate(); err != nil {
+			return fmt.Errorf("restriction/%w", err)
+		}
+	}
+	if v.Pattern != nil {
+		if err := v.Pattern.Validate(); err != nil {
+			return fmt.Errorf("restriction/%w", err)
+		}
+	}
+	return nil
 }
 
 type Schema struct {
@@ -125,6 +504,54 @@ type Schema struct {
 	SimpleType     []SimpleType    `xml:"simpleType"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Schema) Validate() error {
+	if v.Annotation != nil {
+		if err := v.Annotation.Validate(); err != nil {
+			return fmt.Errorf("schema/%w", err)
+		}
+	}
+	if v.AttributeGroup != nil {
+		if err := v.AttributeGroup.Validate(); err != nil {
+			return fmt.Errorf("schema/%w", err)
+		}
+	}
+	if len(v.ComplexType) == 0 {
+		return errors.New("schema/complexType: missing")
+	}
+	for _, v1 := range v.ComplexType {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("schema/%w", err)
+		}
+	}
+	if len(v.Element) == 0 {
+		return errors.New("schema/element: missing")
+	}
+	for _, v1 := range v.Element {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("schema/%w", err)
+		}
+	}
+	if len(v.Import) == 0 {
+		return errors.New("schema/import: missing")
+	}
+	for _, v1 := range v.Import {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("schema/%w", err)
+		}
+	}
+	if len(v.SimpleType) == 0 {
+		return errors.New("schema/simpleType: missing")
+	}
+	for _, v1 := range v.SimpleType {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("schema/%w", err)
+		}
+	}
+	return nil
+}
+
 type Sequence struct {
 	MaxOccurs *string   `xml:"maxOccurs,attr"`
 	MinOccurs *int      `xml:"minOccurs,attr"`
@@ -133,10 +560,52 @@ type Sequence struct {
 	Element   []Element `xml:"element"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Sequence) Validate() error {
+	if v.MaxOccurs != nil {
+		switch *v.MaxOccurs {
+		case "", "unbounded":
+		default:
+			return fmt.Errorf("sequence/@maxOccurs: %q: invalid value", *v.MaxOccurs)
+		}
+	}
+	if v.MinOccurs != nil {
+		if *v.MinOccurs < 0 || *v.MinOccurs > 1 {
+			return fmt.Errorf("sequence/@minOccurs: %d: out of range [0, 1]", *v.MinOccurs)
+		}
+	}
+	if v.Any != nil {
+		if err := v.Any.Validate(); err != nil {
+			return fmt.Errorf("sequence/%w", err)
+		}
+	}
+	for _, v1 := range v.Choice {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("sequence/%w", err)
+		}
+	}
+	for _, v1 := range v.Element {
+		if err := v1.Validate(); err != nil {
+			return fmt.Errorf("sequence/%w", err)
+		}
+	}
+	return nil
+}
+
 type SimpleContent struct {
 	Extension Extension `xml:"extension"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *SimpleContent) Validate() error {
+	if err := v.Extension.Validate(); err != nil {
+		return fmt.Errorf("simpleContent/%w", err)
+	}
+	return nil
+}
+
 type SimpleType struct {
 	Name        string       `xml:"name,attr"`
 	Annotation  *Annotation  `xml:"annotation"`
@@ -145,6 +614,44 @@ type SimpleType struct {
 	Union       *Union       `xml:"union"`
 }
 
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *SimpleType) Validate() error {
+	if length := utf8.RuneCountInString(v.Name); length < 9 || length > 23 {
+		return fmt.Errorf("simpleType/@name: %q: length not in [9, 23]", v.Name)
+	}
+	if v.Annotation != nil {
+		if err := v.Annotation.Validate(); err != nil {
+			return fmt.Errorf("simpleType/%w", err)
+		}
+	}
+	if v.List != nil {
+		if err := v.List.Validate(); err != nil {
+			return fmt.Errorf("simpleType/%w", err)
+		}
+	}
+	if v.Restriction != nil {
+		if err := v.Restriction.Validate(); err != nil {
+			return fmt.Errorf("simpleType/%w", err)
+		}
+	}
+	if v.Union != nil {
+		if err := v.Union.Validate(); err != nil {
+			return fmt.Errorf("simpleType/%w", err)
+		}
+	}
+	return nil
+}
+
 type Union struct {
 	MemberTypes string `xml:"memberTypes,attr"`
 }
+
+// Validate returns an error if v does not satisfy the constraints observed in
+// the XML documents.
+func (v *Union) Validate() error {
+	if length := utf8.RuneCountInString(v.MemberTypes); length < 30 || length > 30 {
+		return fmt.Errorf("union/@memberTypes: %q: length not in [30, 30]", v.MemberTypes)
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ func TestXSD(t *testing.T) {
 		}),
 		xmlstruct.WithNamedTypes(true),
 		xmlstruct.WithPackageName("xsd"),
+		xmlstruct.WithValidateMethods(true),
 	)
 
 	filenames := []string{
@@ -52,6 +53,7 @@ func TestXSD(t *testing.T) {
 
 		var schema xsd.Schema
 		assert.NoError(t, decoder.Decode(&schema))
+		assert.NoError(t, schema.Validate())
 
 		switch filename {
 		case "testdata/kml22gx.xsd":
@@ -0,0 +1,173 @@
+package xmlstruct
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// A pathPattern is a parsed XPath-like pattern that matches elements or
+// attributes by their path from the root element.
+//
+// Patterns are sequences of steps separated by / or //. / matches a child and
+// // matches any descendant. A pattern that does not start with / is matched
+// anywhere, as if it started with //. Each step is a name, optionally prefixed
+// with a namespace prefix, and either the name or the prefix may be *, which
+// matches anything. The last step may be an attribute, prefixed with @. For
+// example, /gpx/trk/*/extensions matches extensions elements that are
+// grandchildren of trk elements, and //@xmlns:* matches all namespace
+// declarations.
+type pathPattern struct {
+	steps []pathStep
+}
+
+// A pathStep is a step in a pathPattern.
+type pathStep struct {
+	attr       bool
+	descendant bool
+	local      string
+	prefix     string
+}
+
+// A pathName is a name in a path, with whether it is an attribute.
+type pathName struct {
+	attr bool
+	name xml.Name
+}
+
+// A namespacePrefixes maps namespace prefixes to the namespace URLs that they
+// have been bound to.
+type namespacePrefixes map[string][]string
+
+var errEmptyPathStep = errors.New("empty step")
+
+// parsePathPattern parses pattern.
+func parsePathPattern(pattern string) (*pathPattern, error) {
+	s, descendant := pattern, true
+	switch {
+	case strings.HasPrefix(s, "//"):
+		s = s[2:]
+	case strings.HasPrefix(s, "/"):
+		s, descendant = s[1:], false
+	}
+	var steps []pathStep
+	for _, stepStr := range strings.Split(s, "/") {
+		if stepStr == "" {
+			if descendant {
+				return nil, fmt.Errorf("%s: %w", pattern, errEmptyPathStep)
+			}
+			descendant = true
+			continue
+		}
+		if len(steps) > 0 && steps[len(steps)-1].attr {
+			return nil, fmt.Errorf("%s: attribute must be the last step", pattern)
+		}
+		step := pathStep{
+			descendant: descendant,
+		}
+		if strings.HasPrefix(stepStr, "@") {
+			step.attr = true
+			stepStr = stepStr[1:]
+		}
+		prefix, local, hasPrefix := strings.Cut(stepStr, ":")
+		if !hasPrefix {
+			prefix, local = "", stepStr
+		}
+		if local == "" || hasPrefix && prefix == "" {
+			return nil, fmt.Errorf("%s: %w", pattern, errEmptyPathStep)
+		}
+		step.prefix, step.local = prefix, local
+		steps = append(steps, step)
+		descendant = false
+	}
+	if len(steps) == 0 || descendant {
+		return nil, fmt.Errorf("%s: %w", pattern, errEmptyPathStep)
+	}
+	return &pathPattern{
+		steps: steps,
+	}, nil
+}
+
+// parsePathPatterns parses patterns.
+func parsePathPatterns(patterns []string) ([]*pathPattern, error) {
+	pathPatterns := make([]*pathPattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		pathPattern, err := parsePathPattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		pathPatterns = append(pathPatterns, pathPattern)
+	}
+	return pathPatterns, nil
+}
+
+// matchesName returns whether p matches elements or attributes by name alone,
+// wherever they are.
+func (p *pathPattern) matchesName() bool {
+	return len(p.steps) == 1 && p.steps[0].descendant
+}
+
+// selectsAttrs returns whether p matches attributes.
+func (p *pathPattern) selectsAttrs() bool {
+	return p.steps[len(p.steps)-1].attr
+}
+
+// match returns whether p matches path, using prefixes to match namespace
+// prefixes.
+func (p *pathPattern) match(path []pathName, prefixes namespacePrefixes) bool {
+	return matchPathSteps(p.steps, path, prefixes)
+}
+
+// matchPathSteps returns whether steps match path.
+func matchPathSteps(steps []pathStep, path []pathName, prefixes namespacePrefixes) bool {
+	if len(steps) == 0 {
+		return len(path) == 0
+	}
+	step := steps[0]
+	if !step.descendant {
+		return len(path) > 0 && step.match(path[0], prefixes) && matchPathSteps(steps[1:], path[1:], prefixes)
+	}
+	for i := range path {
+		if step.match(path[i], prefixes) && matchPathSteps(steps[1:], path[i+1:], prefixes) {
+			return true
+		}
+	}
+	return false
+}
+
+// match returns whether s matches pathName. A namespace prefix matches either
+// the name's space, which is the prefix itself when using raw tokens, or any
+// namespace URL that it has been bound to.
+func (s *pathStep) match(pathName pathName, prefixes namespacePrefixes) bool {
+	switch {
+	case s.attr != pathName.attr:
+		return false
+	case s.local != "*" && s.local != pathName.name.Local:
+		return false
+	case s.prefix == "" || s.prefix == "*" || s.prefix == pathName.name.Space:
+		return true
+	default:
+		return slices.Contains(prefixes[s.prefix], pathName.name.Space)
+	}
+}
+
+// matchAnyPathPattern returns whether any of pathPatterns match path.
+func matchAnyPathPattern(pathPatterns []*pathPattern, path []pathName, prefixes namespacePrefixes) bool {
+	for _, pathPattern := range pathPatterns {
+		if pathPattern.match(path, prefixes) {
+			return true
+		}
+	}
+	return false
+}
+
+// observe records the namespace prefixes declared in attrs.
+func (p namespacePrefixes) observe(attrs []xml.Attr) {
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" && !slices.Contains(p[attr.Name.Local], attr.Value) {
+			p[attr.Name.Local] = append(p[attr.Name.Local], attr.Value)
+		}
+	}
+}
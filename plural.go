@@ -0,0 +1,100 @@
+package xmlstruct
+
+import (
+	"strings"
+	"unicode"
+)
+
+// irregularPlurals are the plurals of English words that do not follow the
+// regular rules. Words that are their own plurals map to themselves.
+var irregularPlurals = map[string]string{
+	"analysis":    "analyses",
+	"axis":        "axes",
+	"calf":        "calves",
+	"child":       "children",
+	"criterion":   "criteria",
+	"data":        "data",
+	"datum":       "data",
+	"equipment":   "equipment",
+	"fish":        "fish",
+	"foot":        "feet",
+	"goose":       "geese",
+	"half":        "halves",
+	"information": "information",
+	"leaf":        "leaves",
+	"man":         "men",
+	"metadata":    "metadata",
+	"mouse":       "mice",
+	"news":        "news",
+	"person":      "people",
+	"self":        "selves",
+	"series":      "series",
+	"sheep":       "sheep",
+	"shelf":       "shelves",
+	"species":     "species",
+	"tooth":       "teeth",
+	"wolf":        "wolves",
+	"woman":       "women",
+}
+
+// repeatedChildName returns name, the name of the field for parent's child
+// element child, pluralized if o.pluralizeRepeated is true and child is
+// repeated.
+func (o *generateOptions) repeatedChildName(parent, child *element, name string) string {
+	if !o.pluralizeRepeated {
+		return name
+	}
+	if _, repeated := parent.repeatedChildren[child.name]; !repeated {
+		return name
+	}
+	if plural, ok := o.pluralOverrides[name]; ok {
+		return plural
+	}
+	return pluralize(name)
+}
+
+// pluralize returns the English plural of the last word of the UpperCamelCase
+// or lowerCamelCase name. Names ending in an initialism, like ID, get an s.
+func pluralize(name string) string {
+	runes := []rune(name)
+	if unicode.IsUpper(runes[len(runes)-1]) {
+		return name + "s"
+	}
+	i := len(runes) - 1
+	for i > 0 && !unicode.IsUpper(runes[i]) {
+		i--
+	}
+	prefix, word := string(runes[:i]), string(runes[i:])
+	lowerWord := strings.ToLower(word)
+	if plural, ok := irregularPlurals[lowerWord]; ok {
+		return prefix + matchFirstRuneCase(word, plural)
+	}
+	switch {
+	case strings.HasSuffix(lowerWord, "sis"):
+		return strings.TrimSuffix(name, "is") + "es"
+	case strings.HasSuffix(lowerWord, "s"),
+		strings.HasSuffix(lowerWord, "x"),
+		strings.HasSuffix(lowerWord, "z"),
+		strings.HasSuffix(lowerWord, "ch"),
+		strings.HasSuffix(lowerWord, "sh"):
+		return name + "es"
+	case strings.HasSuffix(lowerWord, "fe"):
+		return strings.TrimSuffix(name, "fe") + "ves"
+	case strings.HasSuffix(lowerWord, "y") && len(lowerWord) > 1 && !strings.ContainsRune("aeiou", rune(lowerWord[len(lowerWord)-2])):
+		return strings.TrimSuffix(name, "y") + "ies"
+	default:
+		return name + "s"
+	}
+}
+
+// matchFirstRuneCase returns s with its first rune in the same case as the
+// first rune of like.
+func matchFirstRuneCase(like, s string) string {
+	runes := []rune(s)
+	if unicode.IsUpper([]rune(like)[0]) {
+		runes[0] = unicode.ToUpper(runes[0])
+	} else {
+		runes[0] = unicode.ToLower(runes[0])
+	}
+	return string(runes)
+}
@@ -0,0 +1,578 @@
+package xmlstruct
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// relaxNGNamespace is the namespace of RELAX NG schemas in XML syntax.
+const relaxNGNamespace = "http://relaxng.org/ns/structure/1.0"
+
+// relaxNGDataTypeKinds are the kinds of values of the XML Schema data types
+// used in RELAX NG data patterns. Other data types, including dateTime, whose
+// timezone is optional, are strings. Unbounded integer types are big integers.
+var relaxNGDataTypeKinds = map[string]valueKind{
+	"boolean":            valueKindBool,
+	"byte":               valueKindInt,
+	"dateTimeStamp":      valueKindTime,
+	"decimal":            valueKindDecimal,
+	"double":             valueKindFloat64,
+	"float":              valueKindFloat64,
+	"int":                valueKindInt,
+	"integer":            valueKindBigInt,
+	"long":               valueKindInt,
+	"negativeInteger":    valueKindBigInt,
+	"nonNegativeInteger": valueKindBigInt,
+	"nonPositiveInteger": valueKindBigInt,
+	"positiveInteger":    valueKindBigInt,
+	"short":              valueKindInt,
+	"unsignedByte":       valueKindInt,
+	"unsignedInt":        valueKindInt,
+	"unsignedLong":       valueKindUint64,
+	"unsignedShort":      valueKindInt,
+}
+
+// A relaxNGNode is a node in a RELAX NG schema, with its namespace context.
+type relaxNGNode struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr     `xml:",any,attr"`
+	Children []*relaxNGNode `xml:",any"`
+	CharData string         `xml:",chardata"`
+	ns       string
+	prefixes map[string]string
+}
+
+// A relaxNGDefine is the combined definitions of a named pattern.
+type relaxNGDefine struct {
+	combine string
+	nodes   []*relaxNGNode
+}
+
+// A relaxNGValue describes the values of an attribute or of chardata allowed
+// by a pattern.
+type relaxNGValue struct {
+	min        int
+	kind       valueKind
+	enumValues []string
+}
+
+// A relaxNGContent describes the attributes, child elements, and chardata
+// allowed by a pattern.
+type relaxNGContent struct {
+	anyAttrs         bool
+	anyChildElements bool
+	attrNames        []xml.Name
+	attrs            map[xml.Name]*relaxNGValue
+	childNames       []xml.Name
+	childOccurs      map[xml.Name]occurs
+	charData         *relaxNGValue
+}
+
+// A relaxNGParser converts the patterns in a RELAX NG schema into elements.
+type relaxNGParser struct {
+	builder  *schemaBuilder
+	defines  map[string]*relaxNGDefine
+	starts   [][]*relaxNGNode
+	elements []*relaxNGNode
+	visited  map[*relaxNGNode]bool
+}
+
+// ObserveRelaxNG observes the patterns in the RELAX NG schema in XML syntax
+// read from r. The elements matched by the start pattern are roots. Element,
+// attribute, group, interleave, choice, optional, zeroOrMore, oneOrMore, mixed,
+// text, empty, data, value, list, ref, and define patterns are supported, with
+// XML Schema data types. Element and attribute names are names, choices of
+// names, or the wildcards anyName and nsName. Elements and attributes matched
+// by wildcards are kept in catch-all fields, like those added by WithCatchAll.
+// External references and includes are not supported.
+func (g *Generator) ObserveRelaxNG(r io.Reader) error {
+	var root relaxNGNode
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return err
+	}
+	if root.XMLName.Space != relaxNGNamespace {
+		return fmt.Errorf("%s: not a RELAX NG schema", root.XMLName.Local)
+	}
+	root.resolveNamespaces("", nil)
+	p := &relaxNGParser{
+		builder: newSchemaBuilder(g),
+		defines: make(map[string]*relaxNGDefine),
+		visited: make(map[*relaxNGNode]bool),
+	}
+	if root.XMLName.Local == "grammar" {
+		if err := p.collectDefinitions(&root); err != nil {
+			return err
+		}
+	} else {
+		p.starts = append(p.starts, []*relaxNGNode{&root})
+	}
+
+	var startContent relaxNGContent
+	for _, startPatterns := range p.starts {
+		content, err := p.content(startPatterns, 0)
+		if err != nil {
+			return err
+		}
+		startContent = *startContent.choice(content)
+	}
+	p.builder.rootNames = startContent.childNames
+
+	for len(p.elements) > 0 {
+		elementNode := p.elements[0]
+		p.elements = p.elements[1:]
+		if err := p.declareElement(elementNode); err != nil {
+			return err
+		}
+	}
+	return p.builder.finish()
+}
+
+// resolveNamespaces records the namespace context of n and its children, given
+// the inherited default namespace ns and namespace prefixes.
+func (n *relaxNGNode) resolveNamespaces(ns string, prefixes map[string]string) {
+	for _, attr := range n.Attrs {
+		switch {
+		case attr.Name.Space == "" && attr.Name.Local == "ns":
+			ns = attr.Value
+		case attr.Name.Space == "xmlns":
+			prefixes = maps.Clone(prefixes)
+			if prefixes == nil {
+				prefixes = make(map[string]string)
+			}
+			prefixes[attr.Name.Local] = attr.Value
+		}
+	}
+	n.ns, n.prefixes = ns, prefixes
+	for _, child := range n.Children {
+		child.resolveNamespaces(ns, prefixes)
+	}
+}
+
+// attr returns the value of n's unqualified attribute called name.
+func (n *relaxNGNode) attr(name string) (string, bool) {
+	for _, attr := range n.Attrs {
+		if attr.Name.Space == "" && attr.Name.Local == name {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+// patterns returns n's RELAX NG children, ignoring annotations.
+func (n *relaxNGNode) patterns() []*relaxNGNode {
+	patterns := make([]*relaxNGNode, 0, len(n.Children))
+	for _, child := range n.Children {
+		if child.XMLName.Space == relaxNGNamespace {
+			patterns = append(patterns, child)
+		}
+	}
+	return patterns
+}
+
+// qualifiedName returns the name of the element or attribute with QName
+// qName. Unprefixed names are in the namespace ns.
+func (n *relaxNGNode) qualifiedName(qName, ns string) xml.Name {
+	qName = strings.TrimSpace(qName)
+	if prefix, local, ok := strings.Cut(qName, ":"); ok {
+		if space, ok := n.prefixes[prefix]; ok {
+			return xml.Name{Space: space, Local: local}
+		}
+		return xml.Name{Space: prefix, Local: local}
+	}
+	return xml.Name{Space: ns, Local: qName}
+}
+
+// collectDefinitions collects the start and define patterns in grammar.
+func (p *relaxNGParser) collectDefinitions(grammar *relaxNGNode) error {
+	for _, child := range grammar.patterns() {
+		switch child.XMLName.Local {
+		case "start":
+			p.starts = append(p.starts, child.patterns())
+		case "define":
+			name, _ := child.attr("name")
+			define, ok := p.defines[name]
+			if !ok {
+				define = &relaxNGDefine{}
+				p.defines[name] = define
+			}
+			if combine, ok := child.attr("combine"); ok {
+				define.combine = combine
+			}
+			define.nodes = append(define.nodes, child)
+		case "div", "grammar":
+			if err := p.collectDefinitions(child); err != nil {
+				return err
+			}
+		case "include":
+			return errors.New("include: not supported")
+		}
+	}
+	return nil
+}
+
+// nameClass returns the names matched by the name class of the element or
+// attribute pattern n, whether the name class also matches other names, and
+// n's remaining patterns.
+func (p *relaxNGParser) nameClass(n *relaxNGNode, ns string) ([]xml.Name, bool, []*relaxNGNode, error) {
+	patterns := n.patterns()
+	if name, ok := n.attr("name"); ok {
+		return []xml.Name{n.qualifiedName(name, ns)}, false, patterns, nil
+	}
+	if len(patterns) == 0 {
+		return nil, false, nil, fmt.Errorf("%s: missing name", n.XMLName.Local)
+	}
+	names, wildcard, err := p.names(patterns[0], ns)
+	if err != nil {
+		return nil, false, nil, err
+	}
+	return names, wildcard, patterns[1:], nil
+}
+
+// names returns the names matched by the name class n, and whether n also
+// matches other names with the wildcards anyName or nsName. Exceptions to
+// wildcards are ignored.
+func (p *relaxNGParser) names(n *relaxNGNode, ns string) ([]xml.Name, bool, error) {
+	if nameNS, ok := n.attr("ns"); ok {
+		ns = nameNS
+	}
+	switch n.XMLName.Local {
+	case "name":
+		return []xml.Name{n.qualifiedName(n.CharData, ns)}, false, nil
+	case "anyName", "nsName":
+		return nil, true, nil
+	case "choice":
+		var names []xml.Name
+		var wildcard bool
+		for _, child := range n.patterns() {
+			childNames, childWildcard, err := p.names(child, ns)
+			if err != nil {
+				return nil, false, err
+			}
+			names = append(names, childNames...)
+			wildcard = wildcard || childWildcard
+		}
+		return names, wildcard, nil
+	default:
+		return nil, false, fmt.Errorf("%s: name class not supported", n.XMLName.Local)
+	}
+}
+
+// declareElement declares the elements matched by the element pattern n.
+func (p *relaxNGParser) declareElement(n *relaxNGNode) error {
+	names, _, patterns, err := p.nameClass(n, n.ns)
+	if err != nil {
+		return err
+	}
+	content, err := p.content(patterns, 0)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		e := p.builder.declareElement(name)
+		for _, attrName := range content.attrNames {
+			attrValue := content.attrs[attrName]
+			p.builder.declareAttr(e, attrName, attrValue.kind, attrValue.enumValues, attrValue.min > 0)
+		}
+		if content.charData != nil {
+			e.charDataValue.declare(content.charData.kind, content.charData.enumValues)
+		}
+		e.anyAttrs = e.anyAttrs || content.anyAttrs
+		e.anyChildElements = e.anyChildElements || content.anyChildElements
+		p.builder.declareChildElements(e, content.childNames, content.childOccurs)
+	}
+	return nil
+}
+
+// content returns the content allowed by the group of patterns. depth is the
+// depth of nested references, which is used to detect references that do not
+// pass through an element.
+func (p *relaxNGParser) content(patterns []*relaxNGNode, depth int) (*relaxNGContent, error) {
+	result := &relaxNGContent{
+		attrs:       make(map[xml.Name]*relaxNGValue),
+		childOccurs: make(map[xml.Name]occurs),
+	}
+	for _, pattern := range patterns {
+		content, err := p.patternContent(pattern, depth)
+		if err != nil {
+			return nil, err
+		}
+		result = result.group(content)
+	}
+	return result, nil
+}
+
+// patternContent returns the content allowed by the pattern n.
+func (p *relaxNGParser) patternContent(n *relaxNGNode, depth int) (*relaxNGContent, error) {
+	switch n.XMLName.Local {
+	case "element":
+		names, wildcard, _, err := p.nameClass(n, n.ns)
+		if err != nil {
+			return nil, err
+		}
+		if !p.visited[n] && len(names) > 0 {
+			p.visited[n] = true
+			p.elements = append(p.elements, n)
+		}
+		content := &relaxNGContent{
+			anyChildElements: wildcard,
+			childNames:       names,
+			childOccurs:      make(map[xml.Name]occurs),
+		}
+		minOccurs := 1
+		if len(names) > 1 || wildcard {
+			minOccurs = 0
+		}
+		for _, name := range names {
+			content.childOccurs[name] = occurs{min: minOccurs, max: 1}
+		}
+		return content, nil
+	case "attribute":
+		ns, _ := n.attr("ns")
+		names, wildcard, patterns, err := p.nameClass(n, ns)
+		if err != nil {
+			return nil, err
+		}
+		attrValue := &relaxNGValue{
+			min:  1,
+			kind: valueKindString,
+		}
+		if len(patterns) > 0 {
+			valueContent, err := p.content(patterns, depth)
+			if err != nil {
+				return nil, err
+			}
+			if valueContent.charData != nil {
+				attrValue.kind = valueContent.charData.kind
+				attrValue.enumValues = valueContent.charData.enumValues
+			}
+		}
+		content := &relaxNGContent{
+			anyAttrs:  wildcard,
+			attrNames: names,
+			attrs:     make(map[xml.Name]*relaxNGValue),
+		}
+		for _, name := range names {
+			content.attrs[name] = attrValue
+		}
+		return content, nil
+	case "group", "interleave", "list":
+		content, err := p.content(n.patterns(), depth)
+		if err != nil {
+			return nil, err
+		}
+		if n.XMLName.Local == "list" {
+			content.charData = &relaxNGValue{
+				kind: valueKindString,
+			}
+		}
+		return content, nil
+	case "mixed":
+		content, err := p.content(n.patterns(), depth)
+		if err != nil {
+			return nil, err
+		}
+		return content.group(&relaxNGContent{
+			charData: &relaxNGValue{
+				kind: valueKindString,
+			},
+		}), nil
+	case "choice":
+		var result *relaxNGContent
+		for _, pattern := range n.patterns() {
+			content, err := p.patternContent(pattern, depth)
+			if err != nil {
+				return nil, err
+			}
+			if result == nil {
+				result = content
+			} else {
+				result = result.choice(content)
+			}
+		}
+		if result == nil {
+			result = &relaxNGContent{}
+		}
+		return result, nil
+	case "optional", "zeroOrMore", "oneOrMore":
+		content, err := p.content(n.patterns(), depth)
+		if err != nil {
+			return nil, err
+		}
+		switch n.XMLName.Local {
+		case "optional":
+			return content.repeat(0, 1), nil
+		case "zeroOrMore":
+			return content.repeat(0, manyOccurrences), nil
+		default:
+			return content.repeat(1, manyOccurrences), nil
+		}
+	case "text":
+		return &relaxNGContent{
+			charData: &relaxNGValue{
+				kind: valueKindString,
+			},
+		}, nil
+	case "data":
+		dataType, _ := n.attr("type")
+		kind, ok := relaxNGDataTypeKinds[dataType]
+		if !ok {
+			kind = valueKindString
+		}
+		return &relaxNGContent{
+			charData: &relaxNGValue{
+				kind: kind,
+			},
+		}, nil
+	case "value":
+		return &relaxNGContent{
+			charData: &relaxNGValue{
+				kind:       valueKindString,
+				enumValues: []string{n.CharData},
+			},
+		}, nil
+	case "ref":
+		if depth > len(p.defines) {
+			return nil, errors.New("recursive reference not through an element")
+		}
+		name, _ := n.attr("name")
+		define, ok := p.defines[name]
+		if !ok {
+			return nil, fmt.Errorf("%s: undefined pattern", name)
+		}
+		var result *relaxNGContent
+		for _, node := range define.nodes {
+			content, err := p.content(node.patterns(), depth+1)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case result == nil:
+				result = content
+			case define.combine == "interleave":
+				result = result.group(content)
+			default:
+				result = result.choice(content)
+			}
+		}
+		return result, nil
+	case "empty", "notAllowed":
+		return &relaxNGContent{}, nil
+	default:
+		return nil, fmt.Errorf("%s: not supported", n.XMLName.Local)
+	}
+}
+
+// group returns the content of c followed by other. c's maps must be non-nil.
+func (c *relaxNGContent) group(other *relaxNGContent) *relaxNGContent {
+	result := &relaxNGContent{
+		anyAttrs:         c.anyAttrs || other.anyAttrs,
+		anyChildElements: c.anyChildElements || other.anyChildElements,
+		attrNames:        c.attrNames,
+		attrs:            maps.Clone(c.attrs),
+		childNames:       c.childNames,
+		childOccurs:      sequenceOccurs(c.childOccurs, other.childOccurs),
+		charData:         c.charData.merge(other.charData),
+	}
+	for _, attrName := range other.attrNames {
+		attrValue := other.attrs[attrName]
+		if existingAttrValue, ok := result.attrs[attrName]; ok {
+			attrValue = existingAttrValue.merge(attrValue)
+			attrValue.min = max(existingAttrValue.min, other.attrs[attrName].min)
+		} else {
+			result.attrNames = append(slices.Clip(result.attrNames), attrName)
+		}
+		result.attrs[attrName] = attrValue
+	}
+	result.childNames = appendNewNames(result.childNames, other.childNames)
+	return result
+}
+
+// choice returns the content of either c or other.
+func (c *relaxNGContent) choice(other *relaxNGContent) *relaxNGContent {
+	result := &relaxNGContent{
+		anyAttrs:         c.anyAttrs || other.anyAttrs,
+		anyChildElements: c.anyChildElements || other.anyChildElements,
+		attrNames:        appendNewNames(c.attrNames, other.attrNames),
+		attrs:            make(map[xml.Name]*relaxNGValue),
+		childNames:       appendNewNames(c.childNames, other.childNames),
+		childOccurs:      choiceOccurs(c.childOccurs, other.childOccurs),
+		charData:         c.charData.merge(other.charData),
+	}
+	for _, attrName := range result.attrNames {
+		attrValue := c.attrs[attrName].merge(other.attrs[attrName])
+		attrValue.min = min(c.attrs[attrName].minOccurs(), other.attrs[attrName].minOccurs())
+		result.attrs[attrName] = attrValue
+	}
+	return result
+}
+
+// repeat returns the content of c repeated at least minRepeats and at most
+// maxRepeats times.
+func (c *relaxNGContent) repeat(minRepeats, maxRepeats int) *relaxNGContent {
+	result := &relaxNGContent{
+		anyAttrs:         c.anyAttrs,
+		anyChildElements: c.anyChildElements,
+		attrNames:        c.attrNames,
+		attrs:            make(map[xml.Name]*relaxNGValue, len(c.attrs)),
+		childNames:       c.childNames,
+		childOccurs:      repeatOccurs(c.childOccurs, minRepeats, maxRepeats),
+		charData:         c.charData,
+	}
+	for attrName, attrValue := range c.attrs {
+		result.attrs[attrName] = &relaxNGValue{
+			min:        min(attrValue.min, minRepeats),
+			kind:       attrValue.kind,
+			enumValues: attrValue.enumValues,
+		}
+	}
+	return result
+}
+
+// merge returns the values allowed by either v or other, either of which may
+// be nil.
+func (v *relaxNGValue) merge(other *relaxNGValue) *relaxNGValue {
+	switch {
+	case v == nil && other == nil:
+		return nil
+	case v == nil:
+		return &relaxNGValue{min: other.min, kind: other.kind, enumValues: other.enumValues}
+	case other == nil:
+		return &relaxNGValue{min: v.min, kind: v.kind, enumValues: v.enumValues}
+	}
+	result := &relaxNGValue{
+		min:  min(v.min, other.min),
+		kind: v.kind,
+	}
+	if v.kind != other.kind {
+		result.kind = valueKindString
+	}
+	if v.enumValues != nil && other.enumValues != nil {
+		result.enumValues = appendNewNames(v.enumValues, other.enumValues)
+	}
+	return result
+}
+
+// minOccurs returns the minimum number of occurrences of v, which is zero if v
+// is nil.
+func (v *relaxNGValue) minOccurs() int {
+	if v == nil {
+		return 0
+	}
+	return v.min
+}
+
+// appendNewNames returns names with the elements of newNames that are not
+// already in names appended.
+func appendNewNames[T comparable](names, newNames []T) []T {
+	for _, newName := range newNames {
+		if !slices.Contains(names, newName) {
+			names = append(slices.Clip(names), newName)
+		}
+	}
+	return names
+}
@@ -0,0 +1,115 @@
+package xmlstruct
+
+import (
+	"encoding/xml"
+	"fmt"
+	"go/token"
+	"maps"
+	"slices"
+)
+
+// A fieldKey identifies a field of the Go type of an element.
+type fieldKey struct {
+	parent *element
+	attr   bool
+	name   xml.Name
+}
+
+// A renameState is a state when matching a path pattern against the observed
+// elements: the first step steps of the pattern have matched a path ending in
+// element.
+type renameState struct {
+	element *element
+	steps   int
+}
+
+// resolvePathRenames resolves the path patterns in pathRenames against the
+// elements reachable from roots. It returns the new names of the matching
+// fields and the new names of the Go types of the matching elements. If more
+// than one pattern matches then the first in lexical order is used.
+func resolvePathRenames(roots []*element, pathRenames map[string]string) (map[fieldKey]string, map[*element]string, error) {
+	fieldNames := make(map[fieldKey]string)
+	typeNames := make(map[*element]string)
+	patterns := slices.Sorted(maps.Keys(pathRenames))
+	slices.Reverse(patterns)
+	for _, pattern := range patterns {
+		name := pathRenames[pattern]
+		if !token.IsIdentifier(name) {
+			return nil, nil, fmt.Errorf("%s: invalid Go identifier", name)
+		}
+		pathPattern, err := parsePathPattern(pattern)
+		if err != nil {
+			return nil, nil, err
+		}
+		pathPattern.resolve(roots, func(parent *element, child *element, attrName xml.Name) {
+			if child == nil {
+				fieldNames[fieldKey{parent: parent, attr: true, name: attrName}] = name
+				return
+			}
+			if parent != nil {
+				fieldNames[fieldKey{parent: parent, name: child.name}] = name
+			}
+			typeNames[child] = name
+		})
+	}
+	return fieldNames, typeNames, nil
+}
+
+// resolve calls f for each element and attribute reachable from roots that p
+// matches. For elements, f is called with the element's parent, which is nil
+// for roots, and the element. For attributes, f is called with the attribute's
+// element and the attribute's name.
+func (p *pathPattern) resolve(roots []*element, f func(*element, *element, xml.Name)) {
+	visited := make(map[renameState]bool)
+	var visit func(*element, []*element, int)
+	visit = func(parent *element, children []*element, steps int) {
+		// parent is the element at which the first steps steps matched, or nil
+		// if no steps have matched yet.
+		step := &p.steps[steps]
+		if step.attr && parent != nil {
+			for attrName := range parent.attrValues {
+				if step.match(pathName{attr: true, name: attrName}, nil) {
+					f(parent, nil, attrName)
+				}
+			}
+		}
+		for _, child := range children {
+			nextStates := make([]renameState, 0, 2)
+			if !step.attr && step.match(pathName{name: child.name}, nil) {
+				nextStates = append(nextStates, renameState{element: child, steps: steps + 1})
+			}
+			if step.descendant {
+				nextStates = append(nextStates, renameState{element: child, steps: steps})
+			}
+			for _, nextState := range nextStates {
+				if nextState.steps == len(p.steps) {
+					f(parent, child, xml.Name{})
+					continue
+				}
+				if visited[nextState] {
+					continue
+				}
+				visited[nextState] = true
+				visit(child, slices.Collect(maps.Values(child.childElements)), nextState.steps)
+			}
+		}
+	}
+	visit(nil, roots, 0)
+}
+
+// fieldName returns the name of the field for parent's attribute or child
+// element called name, or defaultName if it is not renamed.
+func (o *generateOptions) fieldName(parent *element, name xml.Name, attr bool, defaultName string) string {
+	if fieldName, ok := o.fieldRenames[fieldKey{parent: parent, attr: attr, name: name}]; ok {
+		return fieldName
+	}
+	return defaultName
+}
+
+// typeName returns the name of e's Go type.
+func (o *generateOptions) typeName(e *element) string {
+	if typeName, ok := o.typeRenames[e]; ok {
+		return typeName
+	}
+	return o.exportTypeNameFunc(e.name)
+}
@@ -0,0 +1,215 @@
+package xmlstruct
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// manyOccurrences is the maximum number of occurrences that is distinguished
+// in an occurs. Any greater number of occurrences is many.
+const manyOccurrences = 2
+
+// valueKindDecimal is the kind of values declared by a schema to be decimal
+// numbers, which are counted as float64s that are also decimals. It is only
+// used in declarations.
+const valueKindDecimal = numValueKinds
+
+// An occurs is the minimum and maximum number of times that a child element
+// may occur in a content model, with maximums greater than one capped at
+// manyOccurrences.
+type occurs struct {
+	min int
+	max int
+}
+
+// A schemaBuilder builds elements from the declarations in a schema.
+type schemaBuilder struct {
+	generator    *Generator
+	elements     map[xml.Name]*element
+	elementNames []xml.Name
+	referenced   map[xml.Name]bool
+	rootNames    []xml.Name
+}
+
+// newSchemaBuilder returns a new schemaBuilder that adds elements to g.
+func newSchemaBuilder(g *Generator) *schemaBuilder {
+	return &schemaBuilder{
+		generator:  g,
+		elements:   make(map[xml.Name]*element),
+		referenced: make(map[xml.Name]bool),
+	}
+}
+
+// element returns the element called name, creating it if needed. Elements
+// that have already been observed by g are reused.
+func (b *schemaBuilder) element(name xml.Name) *element {
+	name = b.generator.nameFunc(name)
+	if e, ok := b.elements[name]; ok {
+		return e
+	}
+	e, ok := b.generator.typeElements[name]
+	if !ok {
+		e = newElement(name)
+	}
+	b.elements[name] = e
+	b.elementNames = append(b.elementNames, name)
+	return e
+}
+
+// declareElement records that the element called name is declared by the
+// schema and returns it.
+func (b *schemaBuilder) declareElement(name xml.Name) *element {
+	e := b.element(name)
+	e.observations++
+	return e
+}
+
+// declareAttr records that e has an attribute called name with values of kind.
+// If enumValues is non-nil then the attribute's values are one of enumValues.
+func (b *schemaBuilder) declareAttr(e *element, name xml.Name, kind valueKind, enumValues []string, required bool) {
+	xmlLang := isXMLLang(name)
+	name = b.generator.nameFunc(name)
+	if name == (xml.Name{}) {
+		return
+	}
+	attrValue, ok := e.attrValues[name]
+	if !ok {
+		attrValue = &value{
+			attr:    true,
+			name:    name,
+			xmlLang: xmlLang,
+		}
+		e.attrValues[name] = attrValue
+	} else if !xmlLang {
+		attrValue.xmlLang = false
+	}
+	attrValue.declare(kind, enumValues)
+	if !required {
+		attrValue.optional = true
+	}
+}
+
+// declareChildElements records that the children of e occur as in
+// childOccurs. Child element names are given in the order in childNames.
+func (b *schemaBuilder) declareChildElements(e *element, childNames []xml.Name, childOccurs map[xml.Name]occurs) {
+	for _, childName := range childNames {
+		childElement := b.element(childName)
+		b.referenced[childElement.name] = b.referenced[childElement.name] || childElement != e
+		if childElement == e {
+			e.nestedCount++
+		}
+		e.childElements[childElement.name] = childElement
+		if _, ok := e.childOrder[childElement.name]; !ok {
+			e.childOrder[childElement.name] = b.generator.nextOrder()
+		}
+		occurs := childOccurs[childName]
+		if occurs.min == 0 {
+			e.optionalChildren[childElement.name] = struct{}{}
+		}
+		if occurs.max > 1 {
+			e.repeatedChildren[childElement.name] = struct{}{}
+		}
+	}
+}
+
+// finish adds the built elements to the generator. If rootNames is set then
+// the elements called rootNames are the roots. Otherwise, elements that are
+// not children of any other element are roots, and if no element is a root,
+// then the first declared element is the root. Without named types, the
+// elements reachable from the roots must not be recursive.
+func (b *schemaBuilder) finish() error {
+	g := b.generator
+	var roots []*element
+	for _, name := range b.rootNames {
+		roots = append(roots, b.element(name))
+	}
+	if b.rootNames == nil {
+		for _, name := range b.elementNames {
+			if e := b.elements[name]; !b.referenced[name] && e.observations > 0 {
+				roots = append(roots, e)
+			}
+		}
+	}
+	if len(roots) == 0 && len(b.elementNames) > 0 {
+		roots = append(roots, b.elements[b.elementNames[0]])
+	}
+	for _, root := range roots {
+		root.root = true
+		if !g.topLevelAttributes {
+			clear(root.attrValues)
+			root.anyAttrs = false
+		}
+	}
+
+	typeElements := roots
+	if g.namedTypes {
+		typeElements = typeElements[:0:0]
+		for _, name := range b.elementNames {
+			typeElements = append(typeElements, b.elements[name])
+		}
+	} else {
+		for _, root := range roots {
+			if err := root.checkNotRecursive(nil); err != nil {
+				return err
+			}
+		}
+	}
+	for _, typeElement := range typeElements {
+		g.typeElements[typeElement.name] = typeElement
+		if _, ok := g.typeOrder[typeElement.name]; !ok {
+			g.typeOrder[typeElement.name] = g.nextOrder()
+		}
+	}
+	return nil
+}
+
+// checkNotRecursive returns an error if e contains itself or any of
+// ancestors.
+func (e *element) checkNotRecursive(ancestors []*element) error {
+	for _, ancestor := range ancestors {
+		if ancestor == e {
+			return fmt.Errorf("%s: recursive element requires named types", e.name.Local)
+		}
+	}
+	ancestors = append(ancestors, e)
+	for _, childElement := range e.childElements {
+		if err := childElement.checkNotRecursive(ancestors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// declare records that v is declared by a schema to have values of kind. If
+// enumValues is non-nil then v's values are one of enumValues.
+func (v *value) declare(kind valueKind, enumValues []string) {
+	v.schema = true
+	v.observations++
+	switch kind {
+	case valueKindBool:
+		v.boolCount++
+	case valueKindInt:
+		v.intCount++
+	case valueKindUint64:
+		v.uint64Count++
+	case valueKindBigInt:
+		v.bigIntCount++
+	case valueKindFloat64:
+		v.float64Count++
+	case valueKindDecimal:
+		v.float64Count++
+		v.decimalCount++
+	case valueKindTime:
+		v.timeCount++
+	default:
+		v.stringCount++
+	}
+	if enumValues != nil {
+		if v.enumValues == nil {
+			v.enumValues = make(map[string]struct{})
+		}
+		for _, enumValue := range enumValues {
+			v.enumValues[enumValue] = struct{}{}
+		}
+	}
+}
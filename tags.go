@@ -0,0 +1,96 @@
+package xmlstruct
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// A TagNameCase is the case of names in struct tags other than xml.
+type TagNameCase string
+
+// Tag name cases.
+const (
+	TagNameCaseCamel    TagNameCase = "camel"
+	TagNameCaseOriginal TagNameCase = "original"
+	TagNameCaseSnake    TagNameCase = "snake"
+)
+
+// structTag returns the struct tag for a field with the given xml tag value.
+// If optional is true and o.omitEmpty is true then the xml tag gets omitempty.
+// Each of the additional tags in o.tags gets name converted to o.tagNameCase,
+// with omitempty if optional is true. An empty name excludes the field from the
+// additional tags.
+func (o *generateOptions) structTag(xmlTag, name string, optional bool) string {
+	if optional && o.omitEmpty {
+		xmlTag += ",omitempty"
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "`xml:%q", xmlTag)
+	for _, tag := range o.tags {
+		var tagValue string
+		switch {
+		case name == "":
+			tagValue = "-"
+		case optional:
+			tagValue = o.tagName(name) + ",omitempty"
+		default:
+			tagValue = o.tagName(name)
+		}
+		fmt.Fprintf(&sb, " %s:%q", tag, tagValue)
+	}
+	sb.WriteByte('`')
+	return sb.String()
+}
+
+// tagName returns name converted to o.tagNameCase.
+func (o *generateOptions) tagName(name string) string {
+	switch o.tagNameCase {
+	case TagNameCaseCamel:
+		words := tagNameWords(name)
+		for i, word := range words {
+			if i == 0 {
+				words[i] = strings.ToLower(word)
+			} else {
+				runes := []rune(strings.ToLower(word))
+				runes[0] = unicode.ToUpper(runes[0])
+				words[i] = string(runes)
+			}
+		}
+		return strings.Join(words, "")
+	case TagNameCaseSnake:
+		return strings.ToLower(strings.Join(tagNameWords(name), "_"))
+	default:
+		return name
+	}
+}
+
+// tagNameWords splits name into words at non-alphanumeric runes and at
+// lowerCamelCase and UpperCamelCase word boundaries.
+func tagNameWords(name string) []string {
+	var words []string
+	var word []rune
+	runes := []rune(name)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			continue
+		}
+		if len(word) > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || unicode.IsUpper(prev) && nextIsLower {
+				words = append(words, string(word))
+				word = nil
+			}
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	return words
+}
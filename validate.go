@@ -0,0 +1,279 @@
+package xmlstruct
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// sizedIntGoTypes are the Go types that can be chosen for integers.
+var sizedIntGoTypes = []string{
+	"int8", "int16", "int32", "int64",
+	"uint8", "uint16", "uint32", "uint64",
+}
+
+// hasStructType returns whether e's Go type is a struct.
+func (e *element) hasStructType(options *generateOptions) bool {
+	if options.compactTypes && e.isContainer() {
+		for _, v := range e.childElements {
+			if v == e {
+				return false
+			}
+		}
+	}
+	return len(e.attrValues) != 0 || len(e.childElements) != 0 || e.raw || e.hasWildcards() || e.root && options.namedRoot
+}
+
+// validateReceiver is the receiver of Validate methods. It is fixed, rather
+// than derived from the type name, so that it cannot be shadowed by the local
+// variables declared in checks.
+const validateReceiver = "v"
+
+// writeValidateMethod writes a Validate method for typeName, the Go type of e,
+// to w.
+func (e *element) writeValidateMethod(w io.Writer, typeName string, options *generateOptions) {
+	fmt.Fprintf(w, "\n// Validate returns an error if %s does not satisfy the constraints observed in\n", validateReceiver)
+	fmt.Fprintf(w, "// the XML documents.\n")
+	fmt.Fprintf(w, "func (%s *%s) Validate() error {\n", validateReceiver, typeName)
+	e.writeValidateChecks(w, validateReceiver, e.name.Local, "\t", 0, options)
+	fmt.Fprintf(w, "\treturn nil\n")
+	fmt.Fprintf(w, "}\n")
+}
+
+// writeValidateChecks writes checks of the fields of expr, a value of e's Go
+// type at path, to w.
+func (e *element) writeValidateChecks(w io.Writer, expr, path, indent string, depth int, options *generateOptions) {
+	attrValuesByExportedName := make(map[string]*value, len(e.attrValues))
+	for attrName, attrValue := range e.attrValues {
+		attrValuesByExportedName[options.fieldName(e, attrName, true, options.exportNameFunc(attrName)+options.attrNameSuffix)] = attrValue
+	}
+	for _, exportedAttrName := range slices.Sorted(maps.Keys(attrValuesByExportedName)) {
+		attrValue := attrValuesByExportedName[exportedAttrName]
+		allowEmpty := attrValue.observations < e.observations
+		writeValueChecks(w, attrValue, attrValue.goType(options), expr+"."+exportedAttrName, path+"/@"+attrValue.name.Local, allowEmpty, indent, depth, options)
+	}
+	if e.raw {
+		return
+	}
+
+	if e.charDataValue.observations > 0 {
+		allowEmpty := e.charDataValue.observations < e.observations
+		writeValueChecks(w, &e.charDataValue, "string", expr+"."+options.fieldName(e, xml.Name{}, false, options.charDataFieldName), path, allowEmpty, indent, depth, options)
+	}
+
+	childElementsByExportedName := make(map[string]*element, len(e.childElements))
+	compactChildElements := make(map[*element]bool, len(e.childElements))
+	for _, childElement := range e.childElements {
+		shouldCompact := options.compactTypes &&
+			childElement.isContainer() &&
+			!options.nonCompactableElements[childElement.name]
+		elementOptions := *options
+		if !shouldCompact {
+			elementOptions.compactTypes = false
+		}
+		childElementsByExportedName[options.fieldName(e, childElement.name, false, options.repeatedChildName(e, childElement, exportedNameWithoutSuffix(childElement, &elementOptions))+options.elemNameSuffix)] = childElement
+		compactChildElements[childElement] = shouldCompact
+	}
+	for _, exportedChildName := range slices.Sorted(maps.Keys(childElementsByExportedName)) {
+		childElement := childElementsByExportedName[exportedChildName]
+		shouldCompact := compactChildElements[childElement]
+		_, repeated := e.repeatedChildren[childElement.name]
+		_, optional := e.optionalChildren[childElement.name]
+		currentChild := childElement
+		if shouldCompact {
+			currentChild = firstNotContainerElement(childElement)
+			if !repeated && currentChild != childElement {
+				repeated = isRepeatedInCompactPath(childElement, currentChild)
+			}
+		}
+		childExpr := expr + "." + exportedChildName
+		childPath := path + "/" + strings.ReplaceAll(attrName(childElement, shouldCompact), ">", "/")
+
+		if repeated && !optional {
+			options.importPackageNames["errors"] = struct{}{}
+			fmt.Fprintf(w, "%sif len(%s) == 0 {\n", indent, childExpr)
+			fmt.Fprintf(w, "%s\treturn errors.New(%s)\n", indent, strconv.Quote(childPath+": missing"))
+			fmt.Fprintf(w, "%s}\n", indent)
+		}
+		if currentChild.nillable || repeated && options.langMaps && currentChild.isLangVariant() {
+			continue
+		}
+
+		prefix := ""
+		if repeated {
+			prefix = "[]"
+		} else if optional && options.usePointersForOptionalFields {
+			prefix = "*"
+		}
+
+		// An absent optional child that is not a pointer is unmarshalled as
+		// its zero value, which would fail the checks of a present child.
+		absentAsZero := optional && prefix == ""
+
+		switch _, isNamedType := options.namedTypes[currentChild.name]; {
+		case (isNamedType || currentChild.hasStructType(options)) && absentAsZero:
+			continue
+		case isNamedType:
+			writeElementChecks(w, prefix, childExpr, indent, depth, func(w io.Writer, expr, indent string, depth int) {
+				options.importPackageNames["fmt"] = struct{}{}
+				fmt.Fprintf(w, "%sif err := %s.Validate(); err != nil {\n", indent, expr)
+				fmt.Fprintf(w, "%s\treturn fmt.Errorf(%s, err)\n", indent, strconv.Quote(path+"/%w"))
+				fmt.Fprintf(w, "%s}\n", indent)
+			})
+		case !currentChild.hasStructType(options):
+			allowEmpty := absentAsZero || currentChild.charDataValue.observations < currentChild.observations
+			writeValueChecks(w, &currentChild.charDataValue, prefix+currentChild.charDataValue.goType(options), childExpr, childPath, allowEmpty, indent, depth, options)
+		default:
+			writeElementChecks(w, prefix, childExpr, indent, depth, func(w io.Writer, expr, indent string, depth int) {
+				currentChild.writeValidateChecks(w, expr, childPath, indent, depth, options)
+			})
+		}
+	}
+}
+
+// writeElementChecks writes the checks written by writeChecks for each element
+// of expr, whose Go type has the given slice or pointer prefix, to w.
+func writeElementChecks(w io.Writer, prefix, expr, indent string, depth int, writeChecks func(io.Writer, string, string, int)) {
+	switch prefix {
+	case "[]":
+		elementExpr := "v" + strconv.Itoa(depth+1)
+		checks := &strings.Builder{}
+		writeChecks(checks, elementExpr, indent+"\t", depth+1)
+		if checks.Len() != 0 {
+			fmt.Fprintf(w, "%sfor _, %s := range %s {\n", indent, elementExpr, expr)
+			fmt.Fprintf(w, "%s", checks)
+			fmt.Fprintf(w, "%s}\n", indent)
+		}
+	case "*":
+		checks := &strings.Builder{}
+		writeChecks(checks, expr, indent+"\t", depth)
+		if checks.Len() != 0 {
+			fmt.Fprintf(w, "%sif %s != nil {\n", indent, expr)
+			fmt.Fprintf(w, "%s", checks)
+			fmt.Fprintf(w, "%s}\n", indent)
+		}
+	default:
+		writeChecks(w, expr, indent, depth)
+	}
+}
+
+// writeValueChecks writes checks of expr, a value of goType at path, against
+// the constraints observed for v to w. If allowEmpty is true then expr may
+// also be empty.
+func writeValueChecks(w io.Writer, v *value, goType, expr, path string, allowEmpty bool, indent string, depth int, options *generateOptions) {
+	if v.observations == 0 {
+		return
+	}
+	switch {
+	case strings.HasPrefix(goType, "[]"):
+		writeElementChecks(w, "[]", expr, indent, depth, func(w io.Writer, expr, indent string, depth int) {
+			writeValueChecks(w, v, goType[2:], expr, path, allowEmpty, indent, depth, options)
+		})
+		return
+	case strings.HasPrefix(goType, "*"):
+		writeElementChecks(w, "*", expr, indent, depth, func(w io.Writer, expr, indent string, depth int) {
+			writeValueChecks(w, v, goType[1:], "*"+expr, path, allowEmpty, indent, depth, options)
+		})
+		return
+	}
+	switch {
+	case goType == options.intType || slices.Contains(sizedIntGoTypes, goType):
+		if v.schema || v.intCount == 0 || v.uint64Count != 0 || v.bigIntCount != 0 {
+			return
+		}
+		options.importPackageNames["fmt"] = struct{}{}
+		fmt.Fprintf(w, "%sif %s < %d || %s > %d {\n", indent, expr, v.intMin, expr, v.intMax)
+		fmt.Fprintf(w, "%s\treturn fmt.Errorf(%s, %s)\n", indent, strconv.Quote(fmt.Sprintf("%s: %%d: out of range [%d, %d]", path, v.intMin, v.intMax)), expr)
+		fmt.Fprintf(w, "%s}\n", indent)
+	case goType == "float64":
+		minValue, maxValue, ok := v.floatRange()
+		if v.schema || !ok {
+			return
+		}
+		minLiteral := strconv.FormatFloat(minValue, 'g', -1, 64)
+		maxLiteral := strconv.FormatFloat(maxValue, 'g', -1, 64)
+		options.importPackageNames["fmt"] = struct{}{}
+		fmt.Fprintf(w, "%sif %s < %s || %s > %s {\n", indent, expr, minLiteral, expr, maxLiteral)
+		fmt.Fprintf(w, "%s\treturn fmt.Errorf(%s, %s)\n", indent, strconv.Quote(fmt.Sprintf("%s: %%g: out of range [%s, %s]", path, minLiteral, maxLiteral)), expr)
+		fmt.Fprintf(w, "%s}\n", indent)
+	case options.hasValidateMethod(goType):
+		options.importPackageNames["fmt"] = struct{}{}
+		checkIndent := indent
+		if allowEmpty {
+			fmt.Fprintf(w, "%sif %s != \"\" {\n", indent, expr)
+			checkIndent += "\t"
+		}
+		fmt.Fprintf(w, "%sif err := %s.Validate(); err != nil {\n", checkIndent, strings.TrimPrefix(expr, "*"))
+		fmt.Fprintf(w, "%s\treturn fmt.Errorf(%s, err)\n", checkIndent, strconv.Quote(path+": %w"))
+		fmt.Fprintf(w, "%s}\n", checkIndent)
+		if allowEmpty {
+			fmt.Fprintf(w, "%s}\n", indent)
+		}
+	case goType == "string" && v.fragmented:
+		// The unmarshalled value is the concatenation of several chardata
+		// tokens, which were observed separately.
+		return
+	case goType == "string" && v.isEnum():
+		enumValues := slices.Sorted(maps.Keys(v.enumValues))
+		if allowEmpty && !slices.Contains(enumValues, "") {
+			enumValues = append([]string{""}, enumValues...)
+		}
+		quotedEnumValues := make([]string, 0, len(enumValues))
+		for _, enumValue := range enumValues {
+			quotedEnumValues = append(quotedEnumValues, strconv.Quote(enumValue))
+		}
+		options.importPackageNames["fmt"] = struct{}{}
+		fmt.Fprintf(w, "%sswitch %s {\n", indent, expr)
+		fmt.Fprintf(w, "%scase %s:\n", indent, strings.Join(quotedEnumValues, ", "))
+		fmt.Fprintf(w, "%sdefault:\n", indent)
+		fmt.Fprintf(w, "%s\treturn fmt.Errorf(%s, %s)\n", indent, strconv.Quote(path+": %q: invalid value"), expr)
+		fmt.Fprintf(w, "%s}\n", indent)
+	case goType == "string" && !v.schema:
+		minLength := v.minLength
+		if allowEmpty {
+			minLength = 0
+		}
+		options.importPackageNames["fmt"] = struct{}{}
+		options.importPackageNames["unicode/utf8"] = struct{}{}
+		fmt.Fprintf(w, "%sif length := utf8.RuneCountInString(%s); length < %d || length > %d {\n", indent, expr, minLength, v.maxLength)
+		fmt.Fprintf(w, "%s\treturn fmt.Errorf(%s, %s)\n", indent, strconv.Quote(fmt.Sprintf("%s: %%q: length not in [%d, %d]", path, minLength, v.maxLength)), expr)
+		fmt.Fprintf(w, "%s}\n", indent)
+	}
+}
+
+// hasValidateMethod returns whether goType is a helper type with its own
+// Validate method.
+func (o *generateOptions) hasValidateMethod(goType string) bool {
+	return goType == o.declNames[emailDecl.name] || goType == o.declNames[uuidDecl.name]
+}
+
+// floatRange returns the range of the numbers observed for v, and whether the
+// range is finite and includes all observations.
+func (v *value) floatRange() (float64, float64, bool) {
+	if v.uint64Count != 0 || v.bigIntCount != 0 {
+		return 0, 0, false
+	}
+	minValue, maxValue := math.Inf(1), math.Inf(-1)
+	if v.intCount > 0 {
+		minValue, maxValue = float64(v.intMin), float64(v.intMax)
+	}
+	if v.floatRangeObserved {
+		minValue, maxValue = min(minValue, v.floatMin), max(maxValue, v.floatMax)
+	}
+	if math.IsInf(minValue, 0) || math.IsInf(maxValue, 0) {
+		return 0, 0, false
+	}
+	return minValue, maxValue, true
+}
+
+// isEnum returns whether the values of v are declared by a schema to be
+// enumerated values or, if observed, appear to be drawn from a small set of
+// enumerated values, each of which was observed more than once on average.
+func (v *value) isEnum() bool {
+	return v.enumValues != nil && (v.schema || v.observations >= 2*len(v.enumValues))
+}
@@ -2,42 +2,127 @@ package xmlstruct
 
 import (
 	"encoding/xml"
+	"errors"
+	"fmt"
+	"go/token"
+	"math"
+	"math/rand/v2"
+	"net/mail"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
+// canonicalIntRx matches integers formatted as strconv.FormatInt and
+// strconv.FormatUint would format them, without leading zeros or a plus sign.
+var canonicalIntRx = regexp.MustCompile(`\A(?:0|-?[1-9][0-9]*)\z`)
+
+// maxEnumValues is the maximum number of distinct values of a value that is
+// considered to be an enumeration.
+const maxEnumValues = 8
+
+// maxExamples is the maximum number of example values sampled for field
+// comments.
+const maxExamples = 3
+
+// maxExampleLength is the maximum length in runes of example values in field
+// comments.
+const maxExampleLength = 40
+
+// maxSamplesPerKind is the maximum number of observations of each kind that are
+// sampled for reporting outliers.
+const maxSamplesPerKind = 16
+
+// A valueKind is a kind of observed value.
+type valueKind int
+
+// Value kinds. The kinds up to and including valueKindString are the kinds
+// that are distinguished when choosing a Go type.
+const (
+	valueKindBool valueKind = iota
+	valueKindInt
+	valueKindFloat64
+	valueKindTime
+	valueKindString
+	valueKindUint64
+	valueKindBigInt
+	numValueKinds
+)
+
+// A valueSample is an observed value and the position at which it was
+// observed.
+type valueSample struct {
+	value    string
+	filename string
+	line     int
+	column   int
+}
+
 // A value describes an observed simple value, either an attribute value or
 // chardata.
 type value struct {
-	boolCount    int
-	float64Count int
-	intCount     int
-	name         xml.Name
-	observations int
-	optional     bool
-	repeated     bool
-	stringCount  int
-	timeCount    int
+	attr                 bool
+	base64Count          int
+	bigIntCount          int
+	boolCount            int
+	boolValueCount       int
+	boolValuePair        int
+	boolValuePairsMixed  bool
+	decimalCount         int
+	decimalPrecision     int
+	decimalScale         int
+	emailCount           int
+	entityCount          int
+	entityNames          map[string]string
+	enumValues           map[string]struct{}
+	examples             []string
+	float64Count         int
+	floatMax             float64
+	floatMin             float64
+	floatRangeObserved   bool
+	fragmented           bool
+	hexCount             int
+	intCount             int
+	intMax               int64
+	intMin               int64
+	maxLength            int
+	minLength            int
+	name                 xml.Name
+	nonCanonicalIntCount int
+	observations         int
+	optional             bool
+	repeated             bool
+	samples              map[valueKind][]valueSample
+	schema               bool
+	stringCount          int
+	timeCount            int
+	uint64Count          int
+	urlCount             int
+	uuidCount            int
+	xmlLang              bool
 }
 
 // goType returns the most specific Go type that can represent all of the values
 // observed for v.
 func (v *value) goType(options *generateOptions) string {
-	distinctTypes := 0
-	if v.boolCount > 0 {
-		distinctTypes++
-	}
-	if v.intCount > 0 {
-		distinctTypes++
+	var counts [valueKindString + 1]int
+	for kind := range numValueKinds {
+		counts[v.effectiveKind(kind, options)] += v.count(kind)
 	}
-	if v.float64Count > 0 {
-		distinctTypes++
+	var outlierKinds []valueKind
+	if options.typeConfidence < 1 {
+		counts, outlierKinds = v.vote(counts, options)
 	}
-	if v.timeCount > 0 {
-		distinctTypes++
-	}
-	if v.stringCount > 0 {
-		distinctTypes++
+	distinctTypes := 0
+	for _, count := range counts {
+		if count > 0 {
+			distinctTypes++
+		}
 	}
 	prefix := ""
 	if v.repeated {
@@ -46,48 +131,532 @@ func (v *value) goType(options *generateOptions) string {
 	if options.usePointersForOptionalFields && v.optional {
 		prefix += "*"
 	}
+	var goType string
 	switch {
+	case options.entityEnums && v.entityCount > 0 && v.entityCount == v.observations:
+		goType = options.addEntityEnum(v)
+	case v.observations > 0 && v.boolValueCount == v.observations && !v.boolValuePairsMixed:
+		goType = options.addBoolValuesDecl(v.boolValuePair)
 	case distinctTypes == 0:
 		if options.emptyElements {
 			return "struct{}"
 		}
-		return prefix + "string"
-	case distinctTypes == 1 && v.boolCount > 0:
-		return prefix + "bool"
-	case distinctTypes == 1 && v.intCount > 0:
-		return prefix + options.intType
-	case distinctTypes == 1 && v.float64Count > 0:
-		return prefix + "float64"
-	case distinctTypes == 1 && v.timeCount > 0:
+		goType = "string"
+	case distinctTypes == 1 && counts[valueKindBool] > 0:
+		goType = "bool"
+	case distinctTypes == 1 && counts[valueKindInt] > 0:
+		goType = v.intGoType(options)
+	case distinctTypes == 1 && counts[valueKindFloat64] > 0:
+		goType = v.floatGoType(options)
+	case distinctTypes == 1 && counts[valueKindTime] > 0:
 		options.importPackageNames["time"] = struct{}{}
-		return prefix + "time.Time"
-	case distinctTypes == 2 && v.intCount > 0 && v.float64Count > 0:
-		return prefix + "float64"
+		goType = "time.Time"
+	case distinctTypes == 2 && counts[valueKindInt] > 0 && counts[valueKindFloat64] > 0:
+		goType = v.floatGoType(options)
+	default:
+		goType = v.stringGoType(options)
+	}
+	if len(outlierKinds) > 0 {
+		v.addOutliers(outlierKinds, goType, options)
+	}
+	return prefix + goType
+}
+
+// count returns the number of observations of kind.
+func (v *value) count(kind valueKind) int {
+	switch kind {
+	case valueKindBool:
+		return v.boolCount
+	case valueKindInt:
+		return v.intCount
+	case valueKindUint64:
+		return v.uint64Count
+	case valueKindBigInt:
+		return v.bigIntCount
+	case valueKindFloat64:
+		return v.float64Count
+	case valueKindTime:
+		return v.timeCount
+	case valueKindString:
+		return v.stringCount
+	default:
+		return 0
+	}
+}
+
+// effectiveKind returns the kind that observations of kind are treated as when
+// generating Go types with options.
+func (v *value) effectiveKind(kind valueKind, options *generateOptions) valueKind {
+	switch kind {
+	case valueKindUint64, valueKindBigInt:
+		if !options.sizedIntTypes {
+			return valueKindFloat64
+		}
+		kind = valueKindInt
+	}
+	if kind == valueKindInt && options.preserveLeadingZeros && v.nonCanonicalIntCount > 0 {
+		return valueKindString
+	}
+	return kind
+}
+
+// vote returns counts with all but the dominant kind removed if the dominant
+// kind accounts for at least options.typeConfidence of all observations. It
+// also returns the kinds of the removed observations. Integers are counted
+// towards floats, as both can be represented by a float type.
+func (v *value) vote(counts [valueKindString + 1]int, options *generateOptions) ([valueKindString + 1]int, []valueKind) {
+	for _, dominantKind := range []valueKind{valueKindBool, valueKindInt, valueKindFloat64, valueKindTime} {
+		dominantCount := counts[dominantKind]
+		if dominantKind == valueKindFloat64 {
+			dominantCount += counts[valueKindInt]
+		}
+		if dominantCount == v.observations || float64(dominantCount) < options.typeConfidence*float64(v.observations) {
+			continue
+		}
+		var votedCounts [valueKindString + 1]int
+		votedCounts[dominantKind] = counts[dominantKind]
+		if dominantKind == valueKindFloat64 {
+			votedCounts[valueKindInt] = counts[valueKindInt]
+		}
+		var outlierKinds []valueKind
+		for kind := range numValueKinds {
+			if votedCounts[v.effectiveKind(kind, options)] == 0 && v.count(kind) > 0 {
+				outlierKinds = append(outlierKinds, kind)
+			}
+		}
+		return votedCounts, outlierKinds
+	}
+	return counts, nil
+}
+
+// addOutliers records v's sampled observations of outlierKinds as outliers of
+// goType.
+func (v *value) addOutliers(outlierKinds []valueKind, goType string, options *generateOptions) {
+	var outliers []Outlier
+	for _, kind := range outlierKinds {
+		for _, sample := range v.samples[kind] {
+			outliers = append(outliers, Outlier{
+				Name:     v.name,
+				Attr:     v.attr,
+				Value:    sample.value,
+				GoType:   goType,
+				Filename: sample.filename,
+				Line:     sample.line,
+				Column:   sample.column,
+			})
+		}
+	}
+	options.outliers[v] = outliers
+}
+
+// floatGoType returns the Go type for v's non-integer numbers. If a decimal type
+// is set and all observed numbers are decimals then this is the decimal type,
+// otherwise it is float64.
+func (v *value) floatGoType(options *generateOptions) string {
+	if options.decimalType == "" || v.decimalCount != v.float64Count {
+		return "float64"
+	}
+	goType, importPath, _ := qualifiedGoType(options.decimalType)
+	if importPath == "" {
+		return options.addDecl(newDecimalDecl(goType))
+	}
+	options.importPackageNames[importPath] = struct{}{}
+	return goType
+}
+
+// intGoType returns the Go integer type for v. If sized int types are enabled
+// then this is the narrowest type that can represent the observed range, or,
+// for values declared by a schema, the declared integer types. Otherwise it is
+// the configured int type.
+func (v *value) intGoType(options *generateOptions) string {
+	switch {
+	case !options.sizedIntTypes:
+		return options.intType
+	case v.schema && (v.bigIntCount > 0 || v.uint64Count > 0 && v.intCount > 0):
+		return options.bigIntGoType()
+	case v.schema && v.uint64Count > 0:
+		return "uint64"
+	case v.schema:
+		return options.intType
+	}
+	switch {
+	case v.bigIntCount > 0 || v.uint64Count > 0 && v.intMin < 0:
+		return options.bigIntGoType()
+	case v.uint64Count > 0:
+		return "uint64"
+	case v.intMin >= 0 && v.intMax <= math.MaxUint8:
+		return "uint8"
+	case v.intMin >= 0 && v.intMax <= math.MaxUint16:
+		return "uint16"
+	case v.intMin >= 0 && v.intMax <= math.MaxUint32:
+		return "uint32"
+	case v.intMin >= 0:
+		return "uint64"
+	case v.intMin >= math.MinInt8 && v.intMax <= math.MaxInt8:
+		return "int8"
+	case v.intMin >= math.MinInt16 && v.intMax <= math.MaxInt16:
+		return "int16"
+	case v.intMin >= math.MinInt32 && v.intMax <= math.MaxInt32:
+		return "int32"
+	default:
+		return "int64"
+	}
+}
+
+// bigIntGoType returns the Go type for integers that do not fit in 64 bits.
+func (o *generateOptions) bigIntGoType() string {
+	if o.bigIntType == "*big.Int" {
+		o.importPackageNames["math/big"] = struct{}{}
+		return o.bigIntType
+	}
+	goType, importPath, _ := qualifiedGoType(o.bigIntType)
+	if importPath != "" {
+		o.importPackageNames[importPath] = struct{}{}
+	}
+	return goType
+}
+
+// decimalComment returns a comment with the maximum precision and scale
+// observed for v if goType, v's Go type, is a decimal type from another
+// package, which may not preserve them, or an empty string otherwise.
+func (v *value) decimalComment(goType string, options *generateOptions) string {
+	if options.decimalType == "" || v.decimalPrecision == 0 {
+		return ""
+	}
+	decimalGoType, importPath, _ := qualifiedGoType(options.decimalType)
+	if importPath == "" || strings.TrimLeft(goType, "[]*") != decimalGoType {
+		return ""
+	}
+	return fmt.Sprintf("// precision %d, scale %d", v.decimalPrecision, v.decimalScale)
+}
+
+// fieldComment returns a comment describing the example values observed for v,
+// which was observed v.observations times out of total, or an empty string if
+// there are no example values.
+func (v *value) fieldComment(total int) string {
+	var examples []string
+	for _, example := range v.examples {
+		if runes := []rune(example); len(runes) > maxExampleLength {
+			example = string(runes[:maxExampleLength-1]) + "…"
+		}
+		if quotedExample := strconv.Quote(example); !slices.Contains(examples, quotedExample) {
+			examples = append(examples, quotedExample)
+		}
+	}
+	if len(examples) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("// e.g. %s; seen %d/%d times", strings.Join(examples, ", "), v.observations, total)
+}
+
+// stringGoType returns the Go type for v's strings. If all observed values are
+// binary data or of the same string subtype then this is a binary or subtype
+// type, otherwise it is string.
+func (v *value) stringGoType(options *generateOptions) string {
+	switch {
+	case v.hexCount > 0 && v.hexCount == v.observations:
+		return options.addDecl(hexBinaryDecl)
+	case v.base64Count > 0 && v.base64Count == v.observations:
+		return options.addDecl(base64BinaryDecl)
+	case v.urlCount > 0 && v.urlCount == v.observations:
+		return options.addDecl(urlDecl)
+	case v.uuidCount > 0 && v.uuidCount == v.observations:
+		return options.addDecl(uuidDecl)
+	case v.emailCount > 0 && v.emailCount == v.observations:
+		return options.addDecl(emailDecl)
 	default:
-		return prefix + "string"
+		return "string"
 	}
 }
 
 // observe records s as being observed for v.
 func (v *value) observe(s string, options *observeOptions) {
 	v.observations++
-	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+	if pair, ok := options.boolValues[s]; ok {
+		if v.boolValueCount == 0 {
+			v.boolValuePair = pair
+		} else if pair != v.boolValuePair {
+			v.boolValuePairsMixed = true
+		}
+		v.boolValueCount++
+	}
+	trimmedString := strings.TrimSpace(s)
+	if entityName, ok := options.entities[trimmedString]; ok {
+		if v.entityNames == nil {
+			v.entityNames = make(map[string]string)
+		}
+		// Record s untrimmed, as it will be unmarshalled by encoding/xml, so
+		// that it equals one of the enumerated type's constants.
+		v.entityNames[s] = entityName
+		v.entityCount++
+	}
+	v.observeLength(utf8.RuneCountInString(s))
+	v.observeEnumValue(s)
+	if options.examples {
+		v.observeExample(trimmedString, options.rand)
+	}
+	kind := v.observeKind(s, options)
+	if options.recordSamples && len(v.samples[kind]) < maxSamplesPerKind {
+		if v.samples == nil {
+			v.samples = make(map[valueKind][]valueSample)
+		}
+		line, column := options.inputPos()
+		v.samples[kind] = append(v.samples[kind], valueSample{
+			value:    s,
+			filename: options.filename,
+			line:     line,
+			column:   column,
+		})
+	}
+}
+
+// observeLength records that a value of length runes was observed.
+func (v *value) observeLength(length int) {
+	if v.observations == 1 || length < v.minLength {
+		v.minLength = length
+	}
+	if v.observations == 1 || length > v.maxLength {
+		v.maxLength = length
+	}
+}
+
+// observeEnumValue records s as a possible enumerated value, until more than
+// maxEnumValues distinct values are observed.
+func (v *value) observeEnumValue(s string) {
+	switch {
+	case v.observations == 1:
+		v.enumValues = map[string]struct{}{s: {}}
+	case v.enumValues == nil:
+		// Too many distinct values have already been observed.
+	default:
+		v.enumValues[s] = struct{}{}
+		if len(v.enumValues) > maxEnumValues {
+			v.enumValues = nil
+		}
+	}
+}
+
+// observeExample records s in v's reservoir of example values, so that each
+// observation is equally likely to be kept.
+func (v *value) observeExample(s string, rand *rand.Rand) {
+	if len(v.examples) < maxExamples {
+		v.examples = append(v.examples, s)
+	} else if i := rand.IntN(v.observations); i < maxExamples {
+		v.examples[i] = s
+	}
+}
+
+// observeKind records the kind of s and returns it.
+func (v *value) observeKind(s string, options *observeOptions) valueKind {
+	switch i, err := strconv.ParseInt(s, 10, 64); {
+	case err == nil:
+		if !canonicalIntRx.MatchString(s) {
+			v.nonCanonicalIntCount++
+		}
+		v.observeDecimalDigits(s)
+		if v.intCount == 0 || i < v.intMin {
+			v.intMin = i
+		}
+		if v.intCount == 0 || i > v.intMax {
+			v.intMax = i
+		}
 		v.intCount++
-		return
+		return valueKindInt
+	case errors.Is(err, strconv.ErrRange):
+		if !canonicalIntRx.MatchString(s) {
+			v.nonCanonicalIntCount++
+		}
+		v.observeDecimalDigits(s)
+		if _, err := strconv.ParseUint(s, 10, 64); err == nil {
+			v.uint64Count++
+			return valueKindUint64
+		}
+		v.bigIntCount++
+		return valueKindBigInt
 	}
 	if _, err := strconv.ParseBool(s); err == nil {
 		v.boolCount++
-		return
+		return valueKindBool
 	}
-	if _, err := strconv.ParseFloat(s, 64); err == nil {
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		if v.observeDecimalDigits(s) {
+			v.decimalCount++
+		}
+		if !math.IsNaN(f) {
+			if !v.floatRangeObserved || f < v.floatMin {
+				v.floatMin = f
+			}
+			if !v.floatRangeObserved || f > v.floatMax {
+				v.floatMax = f
+			}
+			v.floatRangeObserved = true
+		}
 		v.float64Count++
-		return
+		return valueKindFloat64
 	}
 	if options.timeLayout != "" {
 		if _, err := time.Parse(options.timeLayout, s); err == nil {
 			v.timeCount++
-			return
+			return valueKindTime
 		}
 	}
 	v.stringCount++
+	if options.binaryMinLength > 0 {
+		v.observeBinary(s, options.binaryMinLength)
+	}
+	if options.stringSubtypes {
+		v.observeStringSubtype(s)
+	}
+	return valueKindString
+}
+
+// observeBinary records whether s, ignoring whitespace, is hex- or
+// base64-encoded binary data at least minLength characters long.
+func (v *value) observeBinary(s string, minLength int) {
+	s = strings.Join(strings.Fields(s), "")
+	if len(s) < minLength {
+		return
+	}
+	if isHex(s) {
+		v.hexCount++
+	}
+	if isBase64(s) {
+		v.base64Count++
+	}
+}
+
+// observeDecimalDigits records the precision and scale of s if s is a decimal
+// number without an exponent. It returns whether s is such a decimal number.
+func (v *value) observeDecimalDigits(s string) bool {
+	precision, scale, ok := decimalDigits(s)
+	if !ok {
+		return false
+	}
+	v.decimalPrecision = max(v.decimalPrecision, precision)
+	v.decimalScale = max(v.decimalScale, scale)
+	return true
+}
+
+// decimalDigits returns the precision, the number of significant digits, and
+// the scale, the number of digits after the decimal point, of s. It returns
+// false if s is not a decimal number without an exponent.
+func decimalDigits(s string) (int, int, bool) {
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	switch {
+	case intPart == "" && fracPart == "":
+		return 0, 0, false
+	case strings.Trim(intPart, "0123456789") != "":
+		return 0, 0, false
+	case strings.Trim(fracPart, "0123456789") != "":
+		return 0, 0, false
+	}
+	intPart = strings.TrimLeft(intPart, "0")
+	return len(intPart) + len(fracPart), len(fracPart), true
+}
+
+// qualifiedGoType returns the Go type and import path for typeName. typeName is
+// either an unqualified type like "Decimal", or a type qualified by its import
+// path like "github.com/shopspring/decimal.Decimal", optionally prefixed by a
+// "*". ok is false if typeName is not a valid type name.
+func qualifiedGoType(typeName string) (goType, importPath string, ok bool) {
+	pointer := ""
+	if strings.HasPrefix(typeName, "*") {
+		pointer, typeName = "*", typeName[1:]
+	}
+	index := strings.LastIndexByte(typeName, '.')
+	if index == -1 {
+		return pointer + typeName, "", token.IsIdentifier(typeName)
+	}
+	importPath, name := typeName[:index], typeName[index+1:]
+	packageName := path.Base(importPath)
+	ok = token.IsIdentifier(packageName) && token.IsIdentifier(name) && !strings.ContainsAny(importPath, " \t\n")
+	return pointer + packageName + "." + name, importPath, ok
+}
+
+// observeStringSubtype records whether s is an absolute URL, a UUID, or an
+// email address.
+func (v *value) observeStringSubtype(s string) {
+	switch {
+	case isURL(s):
+		v.urlCount++
+	case isUUID(s):
+		v.uuidCount++
+	case isEmail(s):
+		v.emailCount++
+	}
+}
+
+// isBase64 returns whether s is padded, standard base64.
+func isBase64(s string) bool {
+	if len(s)%4 != 0 {
+		return false
+	}
+	data := strings.TrimSuffix(strings.TrimSuffix(s, "="), "=")
+	for _, c := range []byte(data) {
+		switch {
+		case 'A' <= c && c <= 'Z':
+		case 'a' <= c && c <= 'z':
+		case '0' <= c && c <= '9':
+		case c == '+' || c == '/':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isHex returns whether s is hex-encoded bytes.
+func isHex(s string) bool {
+	if len(s)%2 != 0 {
+		return false
+	}
+	for _, c := range []byte(s) {
+		if !isHexDigit(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// isHexDigit returns whether c is a hexadecimal digit.
+func isHexDigit(c byte) bool {
+	return '0' <= c && c <= '9' || 'A' <= c && c <= 'F' || 'a' <= c && c <= 'f'
+}
+
+// isEmail returns whether s is a bare email address.
+func isEmail(s string) bool {
+	if !strings.Contains(s, "@") {
+		return false
+	}
+	address, err := mail.ParseAddress(s)
+	return err == nil && address.Name == "" && address.Address == s
+}
+
+// isURL returns whether s is an absolute URL with a host.
+func isURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+// isUUID returns whether s is a UUID in its canonical textual representation.
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+	for i, c := range []byte(s) {
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !isHexDigit(c) {
+				return false
+			}
+		}
+	}
+	return true
 }
@@ -3,34 +3,63 @@ package xmlstruct
 
 import (
 	"encoding/xml"
+	"go/token"
+	"go/types"
+	"math/rand/v2"
 	"regexp"
 	"strings"
 	"unicode"
 )
 
 const (
+	DefaultASCIIIdentifiers             = false
 	DefaultAttrNameSuffix               = ""
+	DefaultBigIntType                   = "*big.Int"
+	DefaultBinaryMinLength              = 0
+	DefaultCatchAll                     = false
 	DefaultCharDataFieldName            = "CharData"
+	DefaultDecimalType                  = ""
 	DefaultElemNameSuffix               = ""
+	DefaultEntityEnums                  = false
+	DefaultFieldComments                = false
 	DefaultFormatSource                 = true
 	DefaultHeader                       = "// Code generated by goxmlstruct. DO NOT EDIT."
+	DefaultTagNameCase                  = TagNameCaseOriginal
 	DefaultTopLevelAttributes           = false
+	DefaultTypeConfidence               = 1
+	DefaultHTMLMode                     = false
 	DefaultImports                      = true
 	DefaultIntType                      = "int"
+	DefaultLangMaps                     = false
+	DefaultNameConflictStrategy         = NameConflictStrategyError
 	DefaultNamedRoot                    = false
 	DefaultNamedTypes                   = false
 	DefaultCompactTypes                 = false
+	DefaultOmitEmpty                    = false
 	DefaultPackageName                  = "main"
+	DefaultPluralizeRepeated            = false
+	DefaultPreserveLeadingZeros         = true
 	DefaultPreserveOrder                = false
+	DefaultSizedIntTypes                = false
+	DefaultStringSubtypes               = false
 	DefaultTimeLayout                   = "2006-01-02T15:04:05Z"
 	DefaultUsePointersForOptionalFields = true
 	DefaultUseRawToken                  = false
+	DefaultValidateMethods              = false
 	DefaultEmptyElements                = true
 )
 
+const (
+	// xmlNamespace is the namespace bound to the xml prefix, used for xml:lang.
+	xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+	// xsiNamespace is the XML Schema instance namespace, used for xsi:nil.
+	xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
 var (
 	kebabOrSnakeCaseWordBoundaryRx = regexp.MustCompile(`[-_]+\pL`)
-	nonIdentifierRuneRx            = regexp.MustCompile(`[^\pL\pN]`)
+	nonIdentifierRuneRx            = regexp.MustCompile(`[^\pL\p{Nd}]`)
 
 	DefaultNameFunc = IgnoreNamespaceNameFunc
 )
@@ -41,10 +70,35 @@ type ExportNameFunc func(xml.Name) string
 // A NameFunc modifies xml.Names observed in the XML documents.
 type NameFunc func(xml.Name) xml.Name
 
+// An Outlier is an observed value that does not match the Go type chosen for
+// its attribute or chardata by majority vote.
+type Outlier struct {
+	Name     xml.Name // The name of the attribute or element.
+	Attr     bool     // Whether Name is an attribute.
+	Value    string   // The observed value.
+	GoType   string   // The Go type chosen by majority vote.
+	Filename string   // The file in which Value was observed, if known.
+	Line     int      // The line at which Value was observed.
+	Column   int      // The column at which Value was observed.
+}
+
 // observeOptions contains options for observing XML documents.
 type observeOptions struct {
+	binaryMinLength    int
+	boolValues         map[string]int
+	entities           map[string]string
+	examples           bool
+	excludePaths       []*pathPattern
+	filename           string
 	getOrder           func() int
+	htmlMode           bool
+	inputPos           func() (int, int)
 	nameFunc           NameFunc
+	namespacePrefixes  namespacePrefixes
+	rand               *rand.Rand
+	rawElements        []*pathPattern
+	recordSamples      bool
+	stringSubtypes     bool
 	timeLayout         string
 	typeOrder          map[xml.Name]int
 	topLevelAttributes bool
@@ -52,28 +106,66 @@ type observeOptions struct {
 	useRawToken        bool
 }
 
+// pathName returns the name of an element or attribute in a path matched
+// against path patterns. In HTML mode, names are case-insensitive, so they
+// are lower-cased like the names of the generated types.
+func (o *observeOptions) pathName(name xml.Name) xml.Name {
+	if o.htmlMode {
+		return lowerCaseName(name)
+	}
+	return name
+}
+
 // generateOptions contains options for generating Go source.
 type generateOptions struct {
 	attrNameSuffix               string
+	bigIntType                   string
+	boolValuesTypeNames          []string
+	catchAll                     bool
 	charDataFieldName            string
 	elemNameSuffix               string
+	entityEnumValues             map[string]map[string]string
+	entityEnums                  bool
 	exportNameFunc               ExportNameFunc
 	exportTypeNameFunc           ExportNameFunc
+	falseValues                  []string
+	fieldComments                bool
+	fieldRenames                 map[fieldKey]string
 	header                       string
 	importPackageNames           map[string]struct{}
 	intType                      string
+	langMaps                     bool
+	nameConflictStrategy         NameConflictStrategy
+	nameConflicts                []NameConflict
 	namedRoot                    bool
 	namedTypes                   map[xml.Name]*element
 	compactTypes                 bool
+	decimalType                  string
+	declNames                    map[string]string
+	decls                        map[string]*decl
 	nonCompactableElements       map[xml.Name]bool
+	omitEmpty                    bool
+	outliers                     map[*value][]Outlier
+	pluralOverrides              map[string]string
+	pluralizeRepeated            bool
+	preserveLeadingZeros         bool
 	preserveOrder                bool
 	simpleTypes                  map[xml.Name]struct{}
+	sizedIntTypes                bool
+	tagNameCase                  TagNameCase
+	tags                         []string
+	trueValues                   []string
+	typeConfidence               float64
+	typeNames                    map[string]struct{}
+	typeRenames                  map[*element]string
 	usePointersForOptionalFields bool
+	validateMethods              bool
 	emptyElements                bool
 }
 
 // DefaultExportNameFunc returns name.Local with kebab- and snake_case words
-// converted to UpperCamelCase and any Id suffix converted to ID.
+// converted to UpperCamelCase and any Id suffix converted to ID. Names that
+// start with a digit are prefixed with X.
 func DefaultExportNameFunc(name xml.Name) string {
 	localName := kebabOrSnakeCaseWordBoundaryRx.ReplaceAllStringFunc(name.Local, func(s string) string {
 		return strings.ToUpper(s[len(s)-1:])
@@ -84,12 +176,13 @@ func DefaultExportNameFunc(name xml.Name) string {
 	if len(runes) > 1 && runes[len(runes)-2] == 'I' && runes[len(runes)-1] == 'd' {
 		runes[len(runes)-1] = 'D'
 	}
-	return string(runes)
+	return escapeName(string(runes), "X")
 }
 
 // DefaultUnexportNameFunc returns name.Local with kebab- and snake_case words
 // converted to lowerCamelCase. Any ID prefix is converted to id, and any Id
-// suffix converted to ID.
+// suffix converted to ID. Names that start with a digit are prefixed with x,
+// and Go keywords and predeclared identifiers get an underscore suffix.
 func DefaultUnexportNameFunc(name xml.Name) string {
 	localName := kebabOrSnakeCaseWordBoundaryRx.ReplaceAllStringFunc(name.Local, func(s string) string {
 		return strings.ToUpper(s[len(s)-1:])
@@ -105,7 +198,22 @@ func DefaultUnexportNameFunc(name xml.Name) string {
 			runes[1] = 'd'
 		}
 	}
-	return string(runes)
+	return escapeName(string(runes), "x")
+}
+
+// escapeName returns name escaped so that it is a valid Go identifier that does
+// not shadow a predeclared identifier. Names that start with a digit are
+// prefixed with prefix, and keywords and predeclared identifiers get an
+// underscore suffix.
+func escapeName(name, prefix string) string {
+	switch {
+	case unicode.IsDigit([]rune(name)[0]):
+		return prefix + name
+	case token.IsKeyword(name) || types.Universe.Lookup(name) != nil:
+		return name + "_"
+	default:
+		return name
+	}
 }
 
 // IgnoreNamespaceNameFunc returns name with name.Space cleared. The same local
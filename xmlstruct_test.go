@@ -2,6 +2,8 @@ package xmlstruct_test
 
 import (
 	"encoding/xml"
+	"go/token"
+	"strings"
 	"testing"
 
 	"github.com/alecthomas/assert/v2"
@@ -56,6 +58,14 @@ func TestDefaultExportNameFunc(t *testing.T) {
 			localName: "+",
 			expected:  "_",
 		},
+		{
+			localName: "3d",
+			expected:  "X3d",
+		},
+		{
+			localName: "string",
+			expected:  "String",
+		},
 	} {
 		t.Run(tc.localName, func(t *testing.T) {
 			t.Parallel()
@@ -123,6 +133,30 @@ func TestDefaultUnexportNameFunc(t *testing.T) {
 			localName: "+",
 			expected:  "_",
 		},
+		{
+			localName: "3d",
+			expected:  "x3d",
+		},
+		{
+			localName: "Type",
+			expected:  "type_",
+		},
+		{
+			localName: "string",
+			expected:  "string_",
+		},
+		{
+			localName: "any",
+			expected:  "any_",
+		},
+		{
+			localName: "nil",
+			expected:  "nil_",
+		},
+		{
+			localName: "x²",
+			expected:  "x_",
+		},
 	} {
 		t.Run(tc.localName, func(t *testing.T) {
 			t.Parallel()
@@ -134,3 +168,26 @@ func TestDefaultUnexportNameFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestNameFuncsKeywords(t *testing.T) {
+	t.Parallel()
+
+	for _, keyword := range []string{
+		"break", "case", "chan", "const", "continue", "default", "defer",
+		"else", "fallthrough", "for", "func", "go", "goto", "if", "import",
+		"interface", "map", "package", "range", "return", "select", "struct",
+		"switch", "type", "var",
+	} {
+		t.Run(keyword, func(t *testing.T) {
+			t.Parallel()
+
+			assert.True(t, token.IsKeyword(keyword))
+			xmlName := xml.Name{
+				Local: keyword,
+			}
+			assert.Equal(t, strings.ToUpper(keyword[:1])+keyword[1:], xmlstruct.DefaultExportNameFunc(xmlName))
+			assert.Equal(t, keyword+"_", xmlstruct.DefaultUnexportNameFunc(xmlName))
+			assert.True(t, token.IsIdentifier(xmlstruct.DefaultUnexportNameFunc(xmlName)))
+		})
+	}
+}